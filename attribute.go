@@ -0,0 +1,301 @@
+package search
+
+//文档的类型化属性字段，以及搜索时按属性字段过滤
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math"
+	"time"
+)
+
+// 属性字段的类型
+const (
+	// 整数，取值可以是任意整数类型
+	Int64Attribute = iota
+
+	// 浮点数，取值可以是浮点数或者整数类型
+	Float64Attribute
+
+	// 时间，取值为time.Time，按Unix纳秒存储
+	TimeAttribute
+
+	// 关键字，取值为字符串，只支持等值过滤
+	// 每个shard为出现过的取值建立字典，没有文档使用的取值会从字典中删除
+	KeywordAttribute
+)
+
+// 属性字段的定义，在EngineInitOptions.Attributes中声明
+type AttributeField struct {
+	Name string
+
+	// 字段类型，见上面的常数
+	Type int
+}
+
+// 一个文档在某个属性字段上的取值
+// Int64Attribute和TimeAttribute使用Int，Float64Attribute使用Float，
+// KeywordAttribute使用Keyword
+type AttributeValue struct {
+	// 文档是否设置了该字段
+	Valid bool
+
+	Int     int64
+	Float   float64
+	Keyword string
+}
+
+// 搜索时对一个属性字段的过滤条件
+// 同时设置范围和取值时两者都要满足，没有设置该字段的文档总是被过滤掉
+type AttributeFilter struct {
+	// 属性字段名
+	Name string
+
+	// 范围过滤，包含两端，为nil时该端不限。只适用于数值和时间字段
+	Min interface{}
+	Max interface{}
+
+	// 等值过滤，等于其中任意一个值即可
+	Values []interface{}
+}
+
+// 生成范围过滤条件，min或者max为nil时该端不限
+func NewRangeFilter(name string, min, max interface{}) AttributeFilter {
+	return AttributeFilter{Name: name, Min: min, Max: max}
+}
+
+// 生成等值过滤条件，字段值等于values中任意一个即可
+func NewTermFilter(name string, values ...interface{}) AttributeFilter {
+	return AttributeFilter{Name: name, Values: values}
+}
+
+// 交给索引器的过滤条件，取值已经按字段类型转换
+type LookupFilter struct {
+	// 字段在属性字段定义中的下标
+	Field int
+
+	// 字段类型
+	Type int
+
+	// 范围的两端，为nil时不限
+	Min *AttributeValue
+	Max *AttributeValue
+
+	// 等值过滤的取值，为空时不做等值过滤
+	Values []AttributeValue
+}
+
+// 判断字段值是否满足过滤条件
+func (filter *LookupFilter) Match(value AttributeValue) bool {
+	if !value.Valid {
+		return false
+	}
+	if filter.Min != nil && compareAttributeValues(filter.Type, value, *filter.Min) < 0 {
+		return false
+	}
+	if filter.Max != nil && compareAttributeValues(filter.Type, value, *filter.Max) > 0 {
+		return false
+	}
+	if len(filter.Values) == 0 {
+		return true
+	}
+	for _, v := range filter.Values {
+		if compareAttributeValues(filter.Type, value, v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// 比较同一类型字段的两个取值，a小于、等于、大于b时分别返回-1、0、1
+func compareAttributeValues(fieldType int, a, b AttributeValue) int {
+	switch fieldType {
+	case Float64Attribute:
+		if a.Float < b.Float {
+			return -1
+		} else if a.Float > b.Float {
+			return 1
+		}
+	case KeywordAttribute:
+		if a.Keyword < b.Keyword {
+			return -1
+		} else if a.Keyword > b.Keyword {
+			return 1
+		}
+	default:
+		if a.Int < b.Int {
+			return -1
+		} else if a.Int > b.Int {
+			return 1
+		}
+	}
+	return 0
+}
+
+// 引擎使用的属性字段定义
+type attributeSchema struct {
+	fields []AttributeField
+
+	// 从字段名到下标
+	index map[string]int
+}
+
+func newAttributeSchema(fields []AttributeField) (*attributeSchema, error) {
+	schema := &attributeSchema{fields: fields, index: make(map[string]int, len(fields))}
+	for i, field := range fields {
+		if field.Name == "" || field.Type < Int64Attribute || field.Type > KeywordAttribute {
+			return nil, fmt.Errorf("%w: 属性字段 \"%s\" 的定义无效", ErrInvalidOptions, field.Name)
+		}
+		if _, found := schema.index[field.Name]; found {
+			return nil, fmt.Errorf("%w: 属性字段 \"%s\" 重复定义", ErrInvalidOptions, field.Name)
+		}
+		schema.index[field.Name] = i
+	}
+	return schema, nil
+}
+
+// 将文档的属性转换为与字段定义一一对应的取值，没有任何属性时返回nil
+func (schema *attributeSchema) values(attributes map[string]interface{}) ([]AttributeValue, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	values := make([]AttributeValue, len(schema.fields))
+	for name, attribute := range attributes {
+		i, found := schema.index[name]
+		if !found {
+			return nil, fmt.Errorf("%w: 未定义的属性字段 \"%s\"", ErrInvalidAttribute, name)
+		}
+		value, err := toAttributeValue(schema.fields[i].Type, attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 属性字段 \"%s\": %v", ErrInvalidAttribute, name, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// 将搜索请求中的过滤条件转换为交给索引器的形式
+func (schema *attributeSchema) filters(filters []AttributeFilter) ([]LookupFilter, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	lookupFilters := make([]LookupFilter, len(filters))
+	for i, filter := range filters {
+		field, found := schema.index[filter.Name]
+		if !found {
+			return nil, fmt.Errorf("%w: 未定义的属性字段 \"%s\"", ErrInvalidFilter, filter.Name)
+		}
+		fieldType := schema.fields[field].Type
+		if fieldType == KeywordAttribute && (filter.Min != nil || filter.Max != nil) {
+			return nil, fmt.Errorf("%w: 关键字字段 \"%s\" 不支持范围过滤", ErrInvalidFilter, filter.Name)
+		}
+		lookupFilter := LookupFilter{Field: field, Type: fieldType}
+		for _, bound := range []struct {
+			value  interface{}
+			target **AttributeValue
+		}{{filter.Min, &lookupFilter.Min}, {filter.Max, &lookupFilter.Max}} {
+			if bound.value == nil {
+				continue
+			}
+			value, err := toAttributeValue(fieldType, bound.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: 属性字段 \"%s\": %v", ErrInvalidFilter, filter.Name, err)
+			}
+			*bound.target = &value
+		}
+		for _, v := range filter.Values {
+			value, err := toAttributeValue(fieldType, v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: 属性字段 \"%s\": %v", ErrInvalidFilter, filter.Name, err)
+			}
+			lookupFilter.Values = append(lookupFilter.Values, value)
+		}
+		lookupFilters[i] = lookupFilter
+	}
+	return lookupFilters, nil
+}
+
+// 按字段类型转换取值
+func toAttributeValue(fieldType int, value interface{}) (AttributeValue, error) {
+	switch fieldType {
+	case Int64Attribute:
+		if i, ok := toInt64(value); ok {
+			return AttributeValue{Valid: true, Int: i}, nil
+		}
+	case Float64Attribute:
+		switch v := value.(type) {
+		case float64:
+			return AttributeValue{Valid: true, Float: v}, nil
+		case float32:
+			return AttributeValue{Valid: true, Float: float64(v)}, nil
+		}
+		if i, ok := toInt64(value); ok {
+			return AttributeValue{Valid: true, Float: float64(i)}, nil
+		}
+	case TimeAttribute:
+		if t, ok := value.(time.Time); ok {
+			return AttributeValue{Valid: true, Int: t.UnixNano()}, nil
+		}
+	case KeywordAttribute:
+		if s, ok := value.(string); ok {
+			return AttributeValue{Valid: true, Keyword: s}, nil
+		}
+	}
+	if overflowsInt64(value) {
+		return AttributeValue{}, fmt.Errorf("取值 %v 超出int64的范围", value)
+	}
+	return AttributeValue{}, fmt.Errorf("取值 %v 的类型 %T 与字段类型不符", value, value)
+}
+
+// 将整数取值转换为int64，不是整数或者无符号整数超出int64的范围时返回false
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint:
+		if uint64(v) <= math.MaxInt64 {
+			return int64(v), true
+		}
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v), true
+		}
+	case uintptr:
+		if uint64(v) <= math.MaxInt64 {
+			return int64(v), true
+		}
+	}
+	return 0, false
+}
+
+// 是否为大于math.MaxInt64的无符号整数
+func overflowsInt64(value interface{}) bool {
+	switch v := value.(type) {
+	case uint:
+		return uint64(v) > math.MaxInt64
+	case uint64:
+		return v > math.MaxInt64
+	case uintptr:
+		return uint64(v) > math.MaxInt64
+	}
+	return false
+}
+
+func init() {
+	// DocumentIndexData.Attributes中的时间需要注册才能用gob写入持久化存储
+	gob.Register(time.Time{})
+}
@@ -0,0 +1,101 @@
+package search_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+func TestUnsignedAttributes(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards:  2,
+		Attributes: []search.AttributeField{{Name: "price", Type: search.Int64Attribute}},
+	})
+	for docId, price := range []interface{}{uint(10), uint64(20), uintptr(30), uint8(40)} {
+		err := engine.IndexDocument(uint64(docId), search.DocumentIndexData{
+			Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": price}})
+		if err != nil {
+			t.Errorf("%T: %v", price, err)
+		}
+	}
+	err := engine.IndexDocument(9, search.DocumentIndexData{
+		Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": uint64(math.MaxInt64) + 1}})
+	if !errors.Is(err, search.ErrInvalidAttribute) {
+		t.Errorf("超出int64范围的取值返回%v，应为ErrInvalidAttribute", err)
+	}
+	engine.FlushIndex()
+
+	docIds := searchDocIds(t, engine, search.SearchRequest{
+		Tokens:  []string{"a"},
+		Filters: []search.AttributeFilter{search.NewRangeFilter("price", uint(15), uint64(35))},
+	})
+	if len(docIds) != 2 {
+		t.Errorf("命中%v，应为文档1和2", docIds)
+	}
+}
+
+func TestKeywordAttributeAfterRemoval(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards:  1,
+		Attributes: []search.AttributeField{{Name: "color", Type: search.KeywordAttribute}},
+	})
+	index := func(docId uint64, color interface{}) {
+		data := search.DocumentIndexData{Tokens: testTokens("a")}
+		if color != nil {
+			data.Attributes = map[string]interface{}{"color": color}
+		}
+		if err := engine.IndexDocument(docId, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	colorDocIds := func(colors ...interface{}) string {
+		docIds := searchDocIds(t, engine, search.SearchRequest{
+			Tokens: []string{"a"}, Filters: []search.AttributeFilter{search.NewTermFilter("color", colors...)}})
+		sort.Slice(docIds, func(i, j int) bool { return docIds[i] < docIds[j] })
+		return fmt.Sprint(docIds)
+	}
+
+	index(1, "red")
+	index(2, "blue")
+	index(3, "red")
+	engine.FlushIndex()
+
+	// 删除和替换之后，不再使用的取值空出的编码被新的取值重用
+	engine.RemoveDocument(1)
+	index(3, "green")
+	index(4, "yellow")
+	index(5, "red")
+	index(2, nil)
+	index(6, "blue")
+	engine.FlushIndex()
+	for colors, expected := range map[string]string{
+		"red": "[5]", "blue": "[6]", "green": "[3]", "yellow": "[4]",
+	} {
+		if docIds := colorDocIds(colors); docIds != expected {
+			t.Errorf("过滤%s命中%s，应为%s", colors, docIds, expected)
+		}
+	}
+	if docIds := colorDocIds("red", "green"); docIds != "[3 5]" {
+		t.Errorf("过滤red和green命中%s", docIds)
+	}
+
+	// 按关键字排序时取到的是重用编码后的取值
+	response, err := engine.Search(search.SearchRequest{
+		Tokens:      []string{"a"},
+		RankOptions: &search.RankOptions{Sort: []search.SortField{{Field: "color"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var docIds []uint64
+	for _, doc := range response.Docs {
+		docIds = append(docIds, doc.DocId)
+	}
+	if fmt.Sprint(docIds) != "[6 3 5 4 2]" {
+		t.Errorf("按color排序为%v", docIds)
+	}
+}
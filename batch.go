@@ -0,0 +1,212 @@
+package search
+
+//批量索引文档
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// 每个分词请求最多包含的文档数
+// 同一shard的文档按此大小切分，使多个分词协程可以并行处理一个shard的文档
+const indexBatchChunkSize = 256
+
+// 批量索引中的一个文档
+type BatchDocument struct {
+	DocId uint64
+	Data  DocumentIndexData
+}
+
+// 批量索引的结果
+//
+// 每个文档加入索引，并且在启用持久化存储时写入数据库之后才算完成。
+// Done关闭之后可以用Err查看每个文档是否成功。
+type IndexBatchResult struct {
+	docIds []uint64
+
+	lock      sync.Mutex
+	errs      []error
+	remaining int
+	done      chan struct{}
+}
+
+func newIndexBatchResult(docs []BatchDocument) *IndexBatchResult {
+	batch := &IndexBatchResult{
+		docIds: make([]uint64, len(docs)),
+		errs:   make([]error, len(docs)),
+		done:   make(chan struct{}),
+	}
+	for i, doc := range docs {
+		batch.docIds[i] = doc.DocId
+	}
+	return batch
+}
+
+// 记录positions处的文档完成了一个步骤，err不为nil时记为失败
+func (batch *IndexBatchResult) finish(positions []int, err error) {
+	batch.lock.Lock()
+	defer batch.lock.Unlock()
+	for _, position := range positions {
+		if err != nil && batch.errs[position] == nil {
+			batch.errs[position] = err
+		}
+	}
+	if len(positions) == 0 {
+		return
+	}
+	batch.remaining -= len(positions)
+	if batch.remaining == 0 {
+		close(batch.done)
+	}
+}
+
+// 批量中的文档数
+func (batch *IndexBatchResult) Len() int {
+	return len(batch.docIds)
+}
+
+// 第i个文档的编号
+func (batch *IndexBatchResult) DocId(i int) uint64 {
+	return batch.docIds[i]
+}
+
+// 第i个文档的错误，nil表示成功或者尚未完成
+// 写入数据库失败时文档仍然在内存索引中，可以被搜索到
+func (batch *IndexBatchResult) Err(i int) error {
+	batch.lock.Lock()
+	defer batch.lock.Unlock()
+	return batch.errs[i]
+}
+
+// 失败的文档数
+func (batch *IndexBatchResult) NumFailed() (numFailed int) {
+	batch.lock.Lock()
+	defer batch.lock.Unlock()
+	for _, err := range batch.errs {
+		if err != nil {
+			numFailed++
+		}
+	}
+	return
+}
+
+// 返回一个信道，全部文档完成时关闭
+func (batch *IndexBatchResult) Done() <-chan struct{} {
+	return batch.done
+}
+
+// 阻塞等待直到全部文档完成，ctx被取消时返回ctx.Err()
+func (batch *IndexBatchResult) Wait(ctx context.Context) error {
+	select {
+	case <-batch.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// 批量索引文档
+//
+// 文档按shard分组后成批交给分词器和索引器，每批只获取一次索引器的锁，
+// 启用持久化存储时按存储shard分组调用SearchPipline.SetBatch。
+// 调用是非同步的，返回的结果在全部文档完成时关闭Done信道。
+// 同一批中重复的docId只索引最后一个，前面的视为成功。
+// 属性无效的文档不会被索引，其错误为ErrInvalidAttribute。
+// 引擎尚未初始化时返回ErrNotInitialized，关闭后返回ErrEngineClosed。
+func (engine *Engine) IndexBatch(docs []BatchDocument) (*IndexBatchResult, error) {
+	if !engine.initialized {
+		return nil, ErrNotInitialized
+	}
+	if err := engine.enter(); err != nil {
+		return nil, err
+	}
+	defer engine.requests.Done()
+
+	batch := newIndexBatchResult(docs)
+
+	// 去掉重复的docId，只保留最后一个
+	last := make(map[uint64]int, len(docs))
+	for i, doc := range docs {
+		last[doc.DocId] = i
+	}
+
+	// 按索引shard和存储shard分组
+	shardPositions := make([][]int, engine.initOptions.NumShards)
+	var storagePositions [][]int
+	if engine.initOptions.UsePersistentStorage {
+		storagePositions = make([][]int, engine.searchpipline.GetStorageShards())
+	}
+	for i, doc := range docs {
+		if last[doc.DocId] != i {
+			continue
+		}
+		if _, err := engine.attributes.values(doc.Data.Attributes); err != nil {
+			// 属性无效的文档不加入索引，直接记为失败
+			batch.errs[i] = err
+			continue
+		}
+		shard := engine.getShard(doc.DocId)
+		shardPositions[shard] = append(shardPositions[shard], i)
+		batch.remaining++
+		if storagePositions != nil {
+			shard := engine.getStorageShard(doc.DocId)
+			storagePositions[shard] = append(storagePositions[shard], i)
+			batch.remaining++
+		}
+	}
+	if batch.remaining == 0 {
+		close(batch.done)
+		return batch, nil
+	}
+
+	// 每个分词请求和存储请求是刷新屏障的一个步骤
+	steps := 0
+	for _, positions := range shardPositions {
+		steps += (len(positions) + indexBatchChunkSize - 1) / indexBatchChunkSize
+	}
+	for _, positions := range storagePositions {
+		if len(positions) > 0 {
+			steps++
+		}
+	}
+	epoch := engine.pending.begin(steps)
+
+	for _, positions := range shardPositions {
+		for start := 0; start < len(positions); start += indexBatchChunkSize {
+			end := start + indexBatchChunkSize
+			if end > len(positions) {
+				end = len(positions)
+			}
+			chunk := positions[start:end]
+			atomic.AddUint64(&engine.numIndexingRequests, uint64(len(chunk)))
+			engine.submitSegmenterRequest(segmenterRequest{
+				docs:      batchDocuments(docs, chunk),
+				epoch:     epoch,
+				batch:     batch,
+				positions: chunk,
+			})
+		}
+	}
+	for shard, positions := range storagePositions {
+		if len(positions) == 0 {
+			continue
+		}
+		engine.persistentStorageIndexDocumentChannels[shard] <- persistentStorageIndexDocumentRequest{
+			docs:      batchDocuments(docs, positions),
+			epoch:     epoch,
+			batch:     batch,
+			positions: positions,
+		}
+	}
+	return batch, nil
+}
+
+// 取出positions处的文档
+func batchDocuments(docs []BatchDocument, positions []int) []BatchDocument {
+	selected := make([]BatchDocument, len(positions))
+	for i, position := range positions {
+		selected[i] = docs[position]
+	}
+	return selected
+}
@@ -0,0 +1,181 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+// 记录写入次数的持久化存储，failShard中的批量写入返回ErrStorage
+type batchPipeline struct {
+	*testPipeline
+	failShard int
+
+	lock       sync.Mutex
+	numSets    int
+	numBatches int
+}
+
+func (self *batchPipeline) Set(shard int, key, value []byte) error {
+	self.lock.Lock()
+	self.numSets++
+	self.lock.Unlock()
+	return self.testPipeline.Set(shard, key, value)
+}
+
+func (self *batchPipeline) SetBatch(shard int, keys, values [][]byte) error {
+	self.lock.Lock()
+	self.numBatches++
+	self.lock.Unlock()
+	if shard == self.failShard {
+		return fmt.Errorf("%w: 写入失败", search.ErrStorage)
+	}
+	return self.testPipeline.SetBatch(shard, keys, values)
+}
+
+// 等待批量索引完成
+func waitBatch(t *testing.T, engine *search.Engine, docs []search.BatchDocument) *search.IndexBatchResult {
+	batch, err := engine.IndexBatch(docs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return batch
+}
+
+// 搜索结果中的文档编号，从小到大排列
+func sortedDocIds(t *testing.T, engine *search.Engine, token string) []uint64 {
+	docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{token}})
+	sort.Slice(docIds, func(i, j int) bool { return docIds[i] < docIds[j] })
+	return docIds
+}
+
+func TestIndexBatchInvalidAttributes(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards:  2,
+		Attributes: []search.AttributeField{{Name: "price", Type: search.Int64Attribute}},
+	})
+	batch := waitBatch(t, engine, []search.BatchDocument{
+		{DocId: 0, Data: search.DocumentIndexData{Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": 10}}},
+		{DocId: 1, Data: search.DocumentIndexData{Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": "10"}}},
+		{DocId: 2, Data: search.DocumentIndexData{Tokens: testTokens("a"), Attributes: map[string]interface{}{"color": 1}}},
+		{DocId: 3, Data: search.DocumentIndexData{Tokens: testTokens("a")}},
+	})
+	if batch.Len() != 4 || batch.DocId(2) != 2 {
+		t.Fatalf("结果中有%d个文档", batch.Len())
+	}
+	if n := batch.NumFailed(); n != 2 {
+		t.Errorf("%d个文档失败，应为2", n)
+	}
+	for i, failed := range []bool{false, true, true, false} {
+		if err := batch.Err(i); failed != errors.Is(err, search.ErrInvalidAttribute) || !failed && err != nil {
+			t.Errorf("文档%d返回%v", i, err)
+		}
+	}
+	if docIds := sortedDocIds(t, engine, "a"); fmt.Sprint(docIds) != "[0 3]" {
+		t.Errorf("命中%v，应为文档0和3", docIds)
+	}
+
+	// 全部无效时直接完成
+	batch, err := engine.IndexBatch([]search.BatchDocument{
+		{DocId: 4, Data: search.DocumentIndexData{Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": "x"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-batch.Done():
+	default:
+		t.Fatal("全部文档无效时没有完成")
+	}
+	if batch.NumFailed() != 1 {
+		t.Errorf("%d个文档失败，应为1", batch.NumFailed())
+	}
+}
+
+func TestIndexBatchDuplicateDocIds(t *testing.T) {
+	pipeline := newTestPipeline(2)
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	batch := waitBatch(t, engine, []search.BatchDocument{
+		{DocId: 1, Data: search.DocumentIndexData{Tokens: testTokens("a")}},
+		{DocId: 2, Data: search.DocumentIndexData{Tokens: testTokens("a")}},
+		{DocId: 1, Data: search.DocumentIndexData{Tokens: testTokens("b")}},
+	})
+	if n := batch.NumFailed(); n != 0 {
+		t.Errorf("%d个文档失败", n)
+	}
+	if docIds := sortedDocIds(t, engine, "a"); fmt.Sprint(docIds) != "[2]" {
+		t.Errorf("搜索a命中%v，应为文档2", docIds)
+	}
+	if docIds := sortedDocIds(t, engine, "b"); fmt.Sprint(docIds) != "[1]" {
+		t.Errorf("搜索b命中%v，应为文档1", docIds)
+	}
+
+	// 存储中也只保留最后一个
+	engine.FlushIndex()
+	if n := pipeline.count(); n != 2 {
+		t.Fatalf("存储中有%d个文档，应为2", n)
+	}
+	recovered := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	if docIds := sortedDocIds(t, recovered, "b"); fmt.Sprint(docIds) != "[1]" {
+		t.Errorf("恢复后搜索b命中%v，应为文档1", docIds)
+	}
+}
+
+func TestIndexBatchPersistence(t *testing.T) {
+	pipeline := &batchPipeline{testPipeline: newTestPipeline(2), failShard: -1}
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	docs := make([]search.BatchDocument, 300)
+	for i := range docs {
+		docs[i] = search.BatchDocument{DocId: uint64(i), Data: search.DocumentIndexData{Tokens: testTokens("a")}}
+	}
+	if batch := waitBatch(t, engine, docs); batch.NumFailed() != 0 {
+		t.Fatalf("%d个文档失败", batch.NumFailed())
+	}
+	if pipeline.count() != 300 {
+		t.Fatalf("存储中有%d个文档，应为300", pipeline.count())
+	}
+	// 每个存储shard写入一次
+	if pipeline.numSets != 0 || pipeline.numBatches != 2 {
+		t.Errorf("调用了%d次Set和%d次SetBatch，应为0和2", pipeline.numSets, pipeline.numBatches)
+	}
+	recovered := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 3, UsePersistentStorage: true, SearchPipline: pipeline})
+	if docIds := sortedDocIds(t, recovered, "a"); len(docIds) != 300 {
+		t.Errorf("恢复了%d个文档，应为300", len(docIds))
+	}
+
+	// 写入失败的文档记为ErrStorage，但仍在内存索引中
+	pipeline.failShard = 1
+	docs = docs[:50]
+	for i := range docs {
+		docs[i].DocId += 1000
+		docs[i].Data.Tokens = testTokens("b")
+	}
+	batch := waitBatch(t, engine, docs)
+	numFailed := 0
+	for i := range docs {
+		if err := batch.Err(i); err != nil {
+			if !errors.Is(err, search.ErrStorage) {
+				t.Errorf("文档%d返回%v，应为ErrStorage", docs[i].DocId, err)
+			}
+			numFailed++
+		}
+	}
+	if numFailed == 0 || numFailed == len(docs) || numFailed != batch.NumFailed() {
+		t.Errorf("%d个文档写入失败", numFailed)
+	}
+	if docIds := sortedDocIds(t, engine, "b"); len(docIds) != len(docs) {
+		t.Errorf("搜索b命中%d个文档，应为%d", len(docIds), len(docs))
+	}
+}
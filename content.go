@@ -0,0 +1,39 @@
+package search
+
+//保存在内存中的文档内容，用于生成高亮片段
+
+import "sync"
+
+// 一个shard的文档内容，仅当EngineInitOptions.StoreContent为true时使用
+type contentStore struct {
+	lock     sync.RWMutex
+	contents map[uint64]string
+}
+
+func newContentStore() *contentStore {
+	return &contentStore{contents: make(map[uint64]string)}
+}
+
+// 保存文档内容，content为空时删除
+func (store *contentStore) set(docId uint64, content string) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	if content == "" {
+		delete(store.contents, docId)
+		return
+	}
+	store.contents[docId] = content
+}
+
+func (store *contentStore) remove(docId uint64) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	delete(store.contents, docId)
+}
+
+func (store *contentStore) get(docId uint64) (string, bool) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+	content, found := store.contents[docId]
+	return content, found
+}
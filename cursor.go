@@ -0,0 +1,61 @@
+package search
+
+//按游标翻页：从上一页最后一个文档之后继续返回结果
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/gob"
+	"fmt"
+)
+
+// 游标中记录的排序位置，即上一页最后一个文档参与排序的全部信息，
+// 以及生成游标时的排序方式
+type searchCursor struct {
+	DocId        uint64
+	Scores       []float32
+	SortValues   []AttributeValue
+	Sort         []SortField
+	ReverseOrder bool
+}
+
+// 生成排在doc之后的游标，options为本次搜索的排序选项
+func encodeCursor(doc *ScoredDocument, options *RankOptions) string {
+	var buf bytes.Buffer
+	cursor := searchCursor{DocId: doc.DocId, Scores: doc.Scores, SortValues: doc.SortValues,
+		Sort: options.Sort, ReverseOrder: options.ReverseOrder}
+	if err := gob.NewEncoder(&buf).Encode(cursor); err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes())
+}
+
+// 解析游标，options为本次搜索的排序选项，与游标不符时说明游标来自其他搜索或者排序方式已经改变
+func decodeCursor(s string, options *RankOptions) (*ScoredDocument, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	var cursor searchCursor
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&cursor); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if !sameSort(cursor.Sort, options.Sort) || len(cursor.SortValues) != len(options.Sort) ||
+		len(options.Sort) == 0 && cursor.ReverseOrder != options.ReverseOrder {
+		return nil, fmt.Errorf("%w: 游标与排序条件不符", ErrInvalidCursor)
+	}
+	return &ScoredDocument{DocId: cursor.DocId, Scores: cursor.Scores, SortValues: cursor.SortValues}, nil
+}
+
+// 两组排序条件是否相同
+func sameSort(a, b []SortField) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
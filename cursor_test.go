@@ -0,0 +1,109 @@
+package search_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+// 用游标逐页取出全部结果，返回拼接后的文档编号
+func searchAllPages(t *testing.T, engine *search.Engine, options search.RankOptions, pageSize int) []uint64 {
+	var docIds []uint64
+	cursor := ""
+	for page := 0; ; page++ {
+		if page > 100 {
+			t.Fatal("翻页没有结束")
+		}
+		options.MaxOutputs = pageSize
+		response, err := engine.Search(search.SearchRequest{
+			Tokens: []string{"a"}, RankOptions: &options, SearchAfter: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Docs) > pageSize {
+			t.Fatalf("第%d页有%d个文档", page, len(response.Docs))
+		}
+		if len(response.Docs) == 0 {
+			if response.Cursor != "" {
+				t.Errorf("没有文档时游标为%q", response.Cursor)
+			}
+			return docIds
+		}
+		for _, doc := range response.Docs {
+			docIds = append(docIds, doc.DocId)
+		}
+		cursor = response.Cursor
+	}
+}
+
+func TestSearchAfterWalksAllPages(t *testing.T) {
+	engine, docs := newSortTestEngine(t)
+	for _, options := range []search.RankOptions{
+		{SearchScorer: docIdModScorer{}},
+		{SearchScorer: docIdModScorer{}, ReverseOrder: true},
+		{SearchScorer: docIdModScorer{}, Sort: []search.SortField{{Field: "brand"}, {Field: "price", Descending: true}}},
+		{SearchScorer: docIdModScorer{}, Sort: []search.SortField{
+			{Field: "price", Missing: search.MissingFirst}, {Field: search.SortByScore}}},
+	} {
+		expected := sortedSearch(t, engine, options.Sort, 0, 0)
+		if options.ReverseOrder {
+			expected = searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"a"}, RankOptions: &options})
+		}
+		for _, pageSize := range []int{1, 7, 60} {
+			docIds := searchAllPages(t, engine, options, pageSize)
+			seen := make(map[uint64]bool)
+			for _, docId := range docIds {
+				if seen[docId] {
+					t.Errorf("%+v每页%d个时文档%d重复出现", options.Sort, pageSize, docId)
+				}
+				seen[docId] = true
+			}
+			if len(docIds) != len(docs) || fmt.Sprint(docIds) != fmt.Sprint(expected) {
+				t.Errorf("%+v每页%d个时翻页结果为\n%v\n应为\n%v", options.Sort, pageSize, docIds, expected)
+			}
+		}
+	}
+}
+
+func TestSearchAfterInvalidCursor(t *testing.T) {
+	engine, _ := newSortTestEngine(t)
+	byBrand := search.RankOptions{Sort: []search.SortField{{Field: "brand"}}, MaxOutputs: 5}
+	response, err := engine.Search(search.SearchRequest{Tokens: []string{"a"}, RankOptions: &byBrand})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := response.Cursor
+
+	for _, c := range []struct {
+		name    string
+		cursor  string
+		options search.RankOptions
+	}{
+		{"无法解码", "!!!", byBrand},
+		{"截断", cursor[:len(cursor)/2], byBrand},
+		{"其他字段排序", cursor, search.RankOptions{Sort: []search.SortField{{Field: "price"}}}},
+		{"排序方向不同", cursor, search.RankOptions{Sort: []search.SortField{{Field: "brand", Descending: true}}}},
+		{"按分数排序", cursor, search.RankOptions{}},
+	} {
+		options := c.options
+		_, err := engine.Search(search.SearchRequest{
+			Tokens: []string{"a"}, RankOptions: &options, SearchAfter: c.cursor})
+		if !errors.Is(err, search.ErrInvalidCursor) {
+			t.Errorf("%s的游标返回%v，应为ErrInvalidCursor", c.name, err)
+		}
+	}
+
+	// 按分数排序的游标不能用于反向排序
+	response, err = engine.Search(search.SearchRequest{
+		Tokens: []string{"a"}, RankOptions: &search.RankOptions{MaxOutputs: 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = engine.Search(search.SearchRequest{Tokens: []string{"a"},
+		RankOptions: &search.RankOptions{ReverseOrder: true}, SearchAfter: response.Cursor})
+	if !errors.Is(err, search.ErrInvalidCursor) {
+		t.Errorf("反向排序使用正向的游标返回%v，应为ErrInvalidCursor", err)
+	}
+}
@@ -0,0 +1,58 @@
+package search
+
+//从持久化存储读取文档
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+// 读取文档索引时提交的DocumentIndexData
+//
+// 文档从持久化存储中读取，需要启用UsePersistentStorage，否则返回ErrNoStorage。
+// 写入存储是非同步的，刚提交的文档在FlushIndex之后才能读到。
+// 文档不存在时返回ErrDocumentNotFound，存储读取失败时返回包装了ErrStorage的错误。
+func (engine *Engine) GetDocument(docId uint64) (DocumentIndexData, error) {
+	if !engine.initialized {
+		return DocumentIndexData{}, ErrNotInitialized
+	}
+	if err := engine.enter(); err != nil {
+		return DocumentIndexData{}, err
+	}
+	defer engine.requests.Done()
+
+	if !engine.initOptions.UsePersistentStorage {
+		return DocumentIndexData{}, ErrNoStorage
+	}
+	return engine.getDocument(docId)
+}
+
+func (engine *Engine) getDocument(docId uint64) (DocumentIndexData, error) {
+	var data DocumentIndexData
+	value, err := engine.searchpipline.Get(engine.getStorageShard(docId), docIdKey(docId))
+	if err != nil {
+		return data, err
+	}
+	if value == nil {
+		return data, ErrDocumentNotFound
+	}
+	if err := gob.NewDecoder(bytes.NewReader(value)).Decode(&data); err != nil {
+		return data, fmt.Errorf("%w: 无法解码文档 %d: %v", ErrStorage, docId, err)
+	}
+	return data, nil
+}
+
+// 为搜索结果读取存储的文档，已经被删除的文档Document为nil
+func (engine *Engine) fillDocuments(docs []ScoredDocument) error {
+	for i := range docs {
+		data, err := engine.getDocument(docs[i].DocId)
+		if err == ErrDocumentNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+		docs[i].Document = &data
+	}
+	return nil
+}
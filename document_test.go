@@ -0,0 +1,104 @@
+package search_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+// 持久化存储中的key，与引擎使用的编码相同
+func testDocIdKey(docId uint64) []byte {
+	key := make([]byte, binary.MaxVarintLen64)
+	return key[:binary.PutUvarint(key, docId)]
+}
+
+func TestGetDocument(t *testing.T) {
+	pipeline := newTestPipeline(1)
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a", "b"), Labels: []string{"x"}})
+	engine.FlushIndex()
+
+	data, err := engine.GetDocument(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(data.Tokens) != fmt.Sprint(testTokens("a", "b")) || fmt.Sprint(data.Labels) != "[x]" {
+		t.Errorf("读取到%+v", data)
+	}
+	if _, err := engine.GetDocument(2); err != search.ErrDocumentNotFound {
+		t.Errorf("不存在的文档返回%v，应为ErrDocumentNotFound", err)
+	}
+
+	// 删除之后读取不到
+	engine.RemoveDocument(1)
+	engine.FlushIndex()
+	if _, err := engine.GetDocument(1); err != search.ErrDocumentNotFound {
+		t.Errorf("删除的文档返回%v，应为ErrDocumentNotFound", err)
+	}
+
+	// 存储中的数据无法解码
+	pipeline.Set(0, testDocIdKey(3), []byte("x"))
+	if _, err := engine.GetDocument(3); !errors.Is(err, search.ErrStorage) {
+		t.Errorf("无法解码的文档返回%v，应为ErrStorage", err)
+	}
+}
+
+func TestGetDocumentWithoutStorage(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{})
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a")})
+	engine.FlushIndex()
+	if _, err := engine.GetDocument(1); err != search.ErrNoStorage {
+		t.Errorf("GetDocument返回%v，应为ErrNoStorage", err)
+	}
+	_, err := engine.Search(search.SearchRequest{Tokens: []string{"a"}, IncludeDocuments: true})
+	if err != search.ErrNoStorage {
+		t.Errorf("IncludeDocuments返回%v，应为ErrNoStorage", err)
+	}
+}
+
+func TestSearchIncludeDocuments(t *testing.T) {
+	pipeline := newTestPipeline(1)
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	for docId := uint64(1); docId <= 3; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{
+			Tokens: testTokens("a"), Labels: []string{fmt.Sprint("label", docId)}})
+	}
+	engine.FlushIndex()
+
+	// 只从存储中删除文档2，它仍能被搜到但读取不到文档
+	pipeline.Delete(0, testDocIdKey(2))
+
+	response, err := engine.Search(search.SearchRequest{Tokens: []string{"a"}, IncludeDocuments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Docs) != 3 {
+		t.Fatalf("命中%d个文档", len(response.Docs))
+	}
+	for _, doc := range response.Docs {
+		if doc.DocId == 2 {
+			if doc.Document != nil {
+				t.Errorf("存储中已删除的文档返回了%+v", doc.Document)
+			}
+			continue
+		}
+		if doc.Document == nil || fmt.Sprint(doc.Document.Labels) != fmt.Sprint("[label", doc.DocId, "]") {
+			t.Errorf("文档%d返回%+v", doc.DocId, doc.Document)
+		}
+	}
+
+	response, err = engine.Search(search.SearchRequest{Tokens: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, doc := range response.Docs {
+		if doc.Document != nil {
+			t.Errorf("没有设置IncludeDocuments时文档%d返回了%+v", doc.DocId, doc.Document)
+		}
+	}
+}
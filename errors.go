@@ -0,0 +1,59 @@
+package search
+
+//搜索引擎返回的错误
+//载入文件和存储相关的错误会包装底层的错误，调用者可以用errors.Is判断类型
+
+import "errors"
+
+var (
+	// 引擎已经关闭，不再接受索引和搜索请求
+	ErrEngineClosed = errors.New("搜索引擎已关闭")
+
+	// 引擎、索引器或者排序器尚未初始化
+	ErrNotInitialized = errors.New("尚未初始化")
+
+	// 引擎、索引器或者排序器被重复初始化
+	ErrAlreadyInitialized = errors.New("不能重复初始化")
+
+	// EngineInitOptions缺少必需的选项，比如CreateIndexer或者CreateRanker
+	ErrInvalidOptions = errors.New("无效的引擎初始化选项")
+
+	// 既没有在SearchRequest.RankOptions中也没有在EngineInitOptions中设置打分器
+	ErrNoScorer = errors.New("必须设置打分器")
+
+	// 无法载入词典文件
+	ErrLoadDictionary = errors.New("无法载入字典文件")
+
+	// 无法载入停用词文件
+	ErrLoadStopTokens = errors.New("无法载入停用词文件")
+
+	// 无法载入同义词文件，或者文件中的规则格式错误
+	ErrLoadSynonyms = errors.New("无法载入同义词文件")
+
+	// 持久化存储读写失败
+	ErrStorage = errors.New("持久化存储错误")
+
+	// 文档的属性字段未定义或者取值类型与定义不符
+	ErrInvalidAttribute = errors.New("无效的文档属性")
+
+	// 搜索请求的过滤条件使用了未定义的属性字段或者取值类型不符
+	ErrInvalidFilter = errors.New("无效的过滤条件")
+
+	// 排序条件使用了未定义的属性字段或者无效的选项
+	ErrInvalidSort = errors.New("无效的排序条件")
+
+	// SearchRequest.SearchAfter不是有效的游标，或者与本次搜索的排序条件不符
+	ErrInvalidCursor = errors.New("无效的翻页游标")
+
+	// 持久化存储中没有该文档
+	ErrDocumentNotFound = errors.New("文档不存在")
+
+	// 读取文档需要启用持久化存储
+	ErrNoStorage = errors.New("没有启用持久化存储")
+
+	// 查询字符串不符合ParseQuery的语法
+	ErrQuerySyntax = errors.New("查询语法错误")
+
+	// 输入提示需要设置EngineInitOptions.EnableSuggestions
+	ErrSuggestionsDisabled = errors.New("没有启用输入提示")
+)
@@ -0,0 +1,52 @@
+package search
+
+//搜索结果的打分过程，用于调试相关度
+
+// 一个文档的打分过程，仅当SearchRequest.Explain为true时生成
+type Explanation struct {
+	// 计算BM25使用的文档总数和平均文本关键词长度
+	NumDocuments uint64
+	AvgDocLength float32
+
+	// 本文档的关键词长度
+	DocLength float32
+
+	// BM25参数
+	K1 float32
+	B  float32
+
+	// 每个参与打分的搜索键，与SearchResponse.Tokens一一对应
+	Terms []TermExplanation
+
+	// 关键词紧邻距离，仅当IndexType == LocationsIndex时有效
+	TokenProximity int32
+
+	// 各搜索键得分之和，同义词组只计得分最高的一项，即IndexedDocument.BM25
+	BM25 float32
+
+	// 打分器的输出
+	Scores []float32
+}
+
+// 一个搜索键对BM25的贡献
+type TermExplanation struct {
+	Term string
+
+	// 搜索键的权重，模糊匹配得到的搜索键小于1
+	Boost float32
+
+	// 搜索键在本文档中的词频，文档中没有该搜索键时为0
+	Frequency float32
+
+	// 包含该搜索键的文档数
+	DocumentFrequency uint64
+
+	// 带平滑的idf，即log2(NumDocuments/DocumentFrequency + 1)
+	IDF float32
+
+	// 文本长度的归一化系数，即1 - B + B*DocLength/AvgDocLength
+	LengthNorm float32
+
+	// 该搜索键的得分，即Boost * IDF * Frequency * (K1 + 1) / (Frequency + K1*LengthNorm)
+	Score float32
+}
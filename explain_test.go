@@ -0,0 +1,108 @@
+package search_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+// 按TermExplanation的注释计算一个搜索键的得分
+func expectedTermScore(boost, frequency float64, documentFrequency, numDocuments uint64,
+	docLength, avgDocLength float64) float64 {
+	const k1, b = 2.0, 0.75
+	if frequency == 0 {
+		return 0
+	}
+	idf := math.Log2(float64(numDocuments)/float64(documentFrequency) + 1)
+	lengthNorm := 1 - b + b*docLength/avgDocLength
+	return boost * idf * frequency * (k1 + 1) / (frequency + k1*lengthNorm)
+}
+
+func almostEqual(a float32, b float64) bool {
+	return math.Abs(float64(a)-b) < 1e-4
+}
+
+// 返回搜索结果中docId的打分过程
+func explainDocument(t *testing.T, engine *search.Engine, query *search.Query, docId uint64) *search.Explanation {
+	response, err := engine.Search(search.SearchRequest{Query: query, Explain: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, doc := range response.Docs {
+		if doc.DocId == docId {
+			if doc.Explanation == nil {
+				t.Fatalf("文档%d没有打分过程", docId)
+			}
+			if len(doc.Scores) == 0 || doc.Explanation.Scores[0] != doc.Scores[0] {
+				t.Errorf("打分过程中的分数为%v，应为%v", doc.Explanation.Scores, doc.Scores)
+			}
+			return doc.Explanation
+		}
+	}
+	t.Fatalf("没有命中文档%d", docId)
+	return nil
+}
+
+func TestExplainValues(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2})
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a", "a", "b")})
+	engine.IndexDocument(2, search.DocumentIndexData{Tokens: testTokens("a", "c")})
+	engine.IndexDocument(3, search.DocumentIndexData{Tokens: testTokens("b", "c", "c", "c")})
+	engine.IndexDocument(4, search.DocumentIndexData{Tokens: testTokens("d")})
+	engine.FlushIndex()
+
+	// 语料统计汇总全部shard：4个文档，共10个关键词，a、b、c各出现在2个文档中
+	const numDocuments, avgDocLength = 4, 2.5
+	boosted := search.NewTermQuery("a")
+	boosted.Boost = 2
+	explanation := explainDocument(t, engine, search.NewOrQuery(boosted, search.NewTermQuery("b")), 1)
+	if explanation.NumDocuments != numDocuments || !almostEqual(explanation.AvgDocLength, avgDocLength) ||
+		explanation.DocLength != 3 || explanation.K1 != 2 || explanation.B != 0.75 {
+		t.Errorf("统计信息为%+v", *explanation)
+	}
+	expected := map[string]struct {
+		boost, frequency float64
+	}{"a": {2, 2}, "b": {1, 1}}
+	if len(explanation.Terms) != len(expected) {
+		t.Fatalf("搜索键为%+v", explanation.Terms)
+	}
+	var sum float64
+	for _, term := range explanation.Terms {
+		e := expected[term.Term]
+		score := expectedTermScore(e.boost, e.frequency, 2, numDocuments, 3, avgDocLength)
+		if term.Boost != float32(e.boost) || term.Frequency != float32(e.frequency) || term.DocumentFrequency != 2 ||
+			!almostEqual(term.IDF, math.Log2(3)) || !almostEqual(term.LengthNorm, 1-0.75+0.75*3/avgDocLength) ||
+			!almostEqual(term.Score, score) {
+			t.Errorf("搜索键%s为%+v，得分应为%v", term.Term, term, score)
+		}
+		sum += score
+	}
+	if !almostEqual(explanation.BM25, sum) {
+		t.Errorf("BM25为%v，应为各搜索键得分之和%v", explanation.BM25, sum)
+	}
+
+	// 文档中没有出现的搜索键得分为0
+	explanation = explainDocument(t, engine, search.NewOrQuery(search.NewTermQuery("a"), search.NewTermQuery("b")), 2)
+	for _, term := range explanation.Terms {
+		if term.Term == "b" && (term.Frequency != 0 || term.Score != 0) {
+			t.Errorf("没有出现的搜索键为%+v", term)
+		}
+	}
+
+	// 同义词组只计得分最高的一项
+	explanation = explainDocument(t, engine, search.NewSynonymQuery(search.NewTermQuery("a"), search.NewTermQuery("b")), 1)
+	scoreA := expectedTermScore(1, 2, 2, numDocuments, 3, avgDocLength)
+	scoreB := expectedTermScore(1, 1, 2, numDocuments, 3, avgDocLength)
+	if len(explanation.Terms) != 2 {
+		t.Fatalf("搜索键为%+v", explanation.Terms)
+	}
+	for _, term := range explanation.Terms {
+		if score := map[string]float64{"a": scoreA, "b": scoreB}[term.Term]; !almostEqual(term.Score, score) {
+			t.Errorf("同义词%s的得分为%v，应为%v", term.Term, term.Score, score)
+		}
+	}
+	if !almostEqual(explanation.BM25, math.Max(scoreA, scoreB)) {
+		t.Errorf("同义词组的BM25为%v，应为%v", explanation.BM25, math.Max(scoreA, scoreB))
+	}
+}
@@ -0,0 +1,90 @@
+package search
+
+//按文档标签的分面统计
+
+import (
+	"sort"
+	"strings"
+)
+
+// 一个分面，统计命中文档中以Prefix开头的各个标签出现的文档数
+//
+// 计数在索引器查找之后、打分器评分之前进行，包括满足查询、标签和属性过滤条件的
+// 全部文档。打分器剔除的文档（Score返回空切片）仍然计入分面，此时分面计数可能
+// 大于SearchResponse.TotalHits，后者只统计评分后保留下来的文档。
+type FacetRequest struct {
+	// 标签前缀，比如"brand:"
+	Prefix string
+
+	// 最多返回的标签数，按文档数从大到小取前Size个，为0时返回全部
+	Size int
+}
+
+// 一个分面的统计结果
+type FacetResult struct {
+	Prefix string
+
+	// 按文档数从大到小排列，文档数相同时按标签排列
+	Values []FacetValue
+}
+
+type FacetValue struct {
+	// 完整的标签，包括前缀
+	Label string
+
+	// 命中文档中带有该标签的文档数
+	Count int
+}
+
+// 统计一个文档的labels中以各前缀开头的标签，累加到counts中，counts与facets一一对应
+// 同一文档中重复出现的标签只计一次
+func countFacets(facets []FacetRequest, labels []string, counts []map[string]int) {
+	for i, facet := range facets {
+		for j, label := range labels {
+			if strings.HasPrefix(label, facet.Prefix) && !containsLabel(labels[:j], label) {
+				counts[i][label]++
+			}
+		}
+	}
+}
+
+// labels中是否有label
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// 汇总各shard的计数，生成按文档数排序并截取前Size个的结果
+func mergeFacets(facets []FacetRequest, shardCounts [][]map[string]int) []FacetResult {
+	results := make([]FacetResult, len(facets))
+	for i, facet := range facets {
+		total := make(map[string]int)
+		for _, counts := range shardCounts {
+			if i < len(counts) {
+				for label, count := range counts[i] {
+					total[label] += count
+				}
+			}
+		}
+
+		values := make([]FacetValue, 0, len(total))
+		for label, count := range total {
+			values = append(values, FacetValue{Label: label, Count: count})
+		}
+		sort.Slice(values, func(a, b int) bool {
+			if values[a].Count != values[b].Count {
+				return values[a].Count > values[b].Count
+			}
+			return values[a].Label < values[b].Label
+		})
+		if facet.Size > 0 && len(values) > facet.Size {
+			values = values[:facet.Size]
+		}
+		results[i] = FacetResult{Prefix: facet.Prefix, Values: values}
+	}
+	return results
+}
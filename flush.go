@@ -0,0 +1,117 @@
+package search
+
+//索引刷新的同步：FlushIndex的屏障和单个文档的索引凭据
+
+import (
+	"context"
+	"sync"
+)
+
+// 索引请求的完成情况
+//
+// 每个索引和删除请求在提交时记录当前的刷新轮次，并登记需要完成的步骤数
+// （写入索引，以及启用持久化存储时写入数据库）。FlushIndex开始新的一轮，
+// 然后等待此前各轮登记的步骤全部完成，之后提交的请求不会让它一直等下去。
+type pendingRequests struct {
+	lock sync.Mutex
+	cond *sync.Cond
+
+	// 当前的刷新轮次
+	epoch uint64
+
+	// 每一轮尚未完成的步骤数，完成的轮次从表中删除
+	steps map[uint64]int
+}
+
+func (pending *pendingRequests) init() {
+	pending.cond = sync.NewCond(&pending.lock)
+	pending.steps = make(map[uint64]int)
+}
+
+// 登记一个需要完成steps个步骤的请求，返回请求所属的轮次
+func (pending *pendingRequests) begin(steps int) uint64 {
+	pending.lock.Lock()
+	defer pending.lock.Unlock()
+	pending.steps[pending.epoch] += steps
+	return pending.epoch
+}
+
+// 记录epoch轮中的一个步骤已经完成
+func (pending *pendingRequests) done(epoch uint64) {
+	pending.lock.Lock()
+	defer pending.lock.Unlock()
+	pending.steps[epoch]--
+	if pending.steps[epoch] == 0 {
+		delete(pending.steps, epoch)
+	}
+	pending.cond.Broadcast()
+}
+
+// 开始新的一轮并等待此前登记的请求全部完成，ctx被取消时返回ctx.Err()
+func (pending *pendingRequests) wait(ctx context.Context) error {
+	// ctx被取消时唤醒等待的协程
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-ctx.Done():
+			pending.lock.Lock()
+			pending.cond.Broadcast()
+			pending.lock.Unlock()
+		case <-finished:
+		}
+	}()
+
+	pending.lock.Lock()
+	defer pending.lock.Unlock()
+	target := pending.epoch
+	pending.epoch++
+	for pending.hasSteps(target) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pending.cond.Wait()
+	}
+	return nil
+}
+
+// 是否还有不晚于target轮的步骤没有完成，调用者必须持有锁
+func (pending *pendingRequests) hasSteps(target uint64) bool {
+	for epoch := range pending.steps {
+		if epoch <= target {
+			return true
+		}
+	}
+	return false
+}
+
+// 一次IndexDocumentTicket调用的凭据
+// 文档加入所在shard的索引之后，Search就可以查找到该文档
+type IndexTicket struct {
+	docId uint64
+	done  chan struct{}
+}
+
+func newIndexTicket(docId uint64) *IndexTicket {
+	return &IndexTicket{docId: docId, done: make(chan struct{})}
+}
+
+// 返回文档编号
+func (ticket *IndexTicket) DocId() uint64 {
+	return ticket.docId
+}
+
+// 返回一个信道，文档可以被搜索到时关闭
+func (ticket *IndexTicket) Done() <-chan struct{} {
+	return ticket.done
+}
+
+// 阻塞等待直到文档可以被搜索到，ctx被取消时返回ctx.Err()
+func (ticket *IndexTicket) Wait(ctx context.Context) error {
+	select {
+	case <-ticket.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
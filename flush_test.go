@@ -0,0 +1,195 @@
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aosen/search"
+	"github.com/aosen/search/indexer"
+)
+
+// 每次写入数据库都要等gate放行的持久化存储
+type gatedPipeline struct {
+	*testPipeline
+	gate chan struct{}
+}
+
+func (self *gatedPipeline) Set(shard int, key, value []byte) error {
+	<-self.gate
+	return self.testPipeline.Set(shard, key, value)
+}
+
+// 每次加入文档都要等gate放行的索引器
+type gatedIndexer struct {
+	*indexer.WuKongIndexer
+	gate chan struct{}
+}
+
+func (self *gatedIndexer) AddDocument(document *search.DocumentIndex) {
+	<-self.gate
+	self.WuKongIndexer.AddDocument(document)
+}
+
+// 等待FlushIndexContext返回，超时时测试失败
+func waitFlushed(t *testing.T, flushed <-chan error) error {
+	select {
+	case err := <-flushed:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("FlushIndexContext没有返回")
+	}
+	return nil
+}
+
+// 确认FlushIndexContext还在等待
+func checkNotFlushed(t *testing.T, flushed <-chan error) {
+	select {
+	case err := <-flushed:
+		t.Fatalf("请求还没有完成时FlushIndexContext返回%v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestFlushIndexWaitsForEarlierRequests(t *testing.T) {
+	pipeline := &gatedPipeline{testPipeline: newTestPipeline(1), gate: make(chan struct{})}
+	defer close(pipeline.gate)
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a")})
+	flushed := make(chan error, 1)
+	go func() { flushed <- engine.FlushIndexContext(context.Background()) }()
+	checkNotFlushed(t, flushed)
+
+	// 刷新开始后提交的请求不会延长等待，存储协程忙时IndexDocument会阻塞，因此在另一个协程中提交
+	go engine.IndexDocument(2, search.DocumentIndexData{Tokens: testTokens("a")})
+	checkNotFlushed(t, flushed)
+	pipeline.gate <- struct{}{}
+	if err := waitFlushed(t, flushed); err != nil {
+		t.Fatal(err)
+	}
+	if n := pipeline.count(); n != 1 {
+		t.Fatalf("刷新后存储中有%d个文档，应为1", n)
+	}
+
+	// 下一次刷新等待文档2写入数据库
+	go func() { flushed <- engine.FlushIndexContext(context.Background()) }()
+	checkNotFlushed(t, flushed)
+	pipeline.gate <- struct{}{}
+	if err := waitFlushed(t, flushed); err != nil {
+		t.Fatal(err)
+	}
+	if n := pipeline.count(); n != 2 {
+		t.Fatalf("刷新后存储中有%d个文档，应为2", n)
+	}
+}
+
+func TestFlushIndexContextCancelled(t *testing.T) {
+	pipeline := &gatedPipeline{testPipeline: newTestPipeline(1), gate: make(chan struct{})}
+	defer close(pipeline.gate)
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a")})
+
+	// 等待期间取消
+	ctx, cancel := context.WithCancel(context.Background())
+	flushed := make(chan error, 1)
+	go func() { flushed <- engine.FlushIndexContext(ctx) }()
+	checkNotFlushed(t, flushed)
+	cancel()
+	if err := waitFlushed(t, flushed); err != context.Canceled {
+		t.Fatalf("取消后返回%v，应为context.Canceled", err)
+	}
+
+	// 调用前已经取消
+	if err := engine.FlushIndexContext(ctx); err != context.Canceled {
+		t.Fatalf("已取消的ctx返回%v，应为context.Canceled", err)
+	}
+
+	// 超时
+	timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer timeoutCancel()
+	if err := engine.FlushIndexContext(timeoutCtx); err != context.DeadlineExceeded {
+		t.Fatalf("超时后返回%v，应为context.DeadlineExceeded", err)
+	}
+
+	// 取消不影响之后的刷新
+	go func() { flushed <- engine.FlushIndexContext(context.Background()) }()
+	checkNotFlushed(t, flushed)
+	pipeline.gate <- struct{}{}
+	if err := waitFlushed(t, flushed); err != nil {
+		t.Fatal(err)
+	}
+	if n := pipeline.count(); n != 1 {
+		t.Fatalf("刷新后存储中有%d个文档，应为1", n)
+	}
+}
+
+func TestIndexTicketReadYourWrites(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 4})
+	for docId := uint64(0); docId < 50; docId++ {
+		token := fmt.Sprint("t", docId)
+		ticket, err := engine.IndexDocumentTicket(docId, search.DocumentIndexData{Tokens: testTokens(token)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ticket.DocId() != docId {
+			t.Fatalf("凭据的文档编号为%d，应为%d", ticket.DocId(), docId)
+		}
+		if err := ticket.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{token}}); fmt.Sprint(docIds) != fmt.Sprint([]uint64{docId}) {
+			t.Fatalf("凭据完成后搜索%s命中%v", token, docIds)
+		}
+	}
+
+	// 替换后的文档同样可以立即搜到，旧的关键词不再命中
+	ticket, err := engine.IndexDocumentTicket(0, search.DocumentIndexData{Tokens: testTokens("new")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-ticket.Done()
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"new"}}); fmt.Sprint(docIds) != "[0]" {
+		t.Errorf("替换后搜索new命中%v", docIds)
+	}
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"t0"}}); len(docIds) != 0 {
+		t.Errorf("替换后搜索t0命中%v", docIds)
+	}
+}
+
+func TestIndexTicketWaitCancelled(t *testing.T) {
+	gate := make(chan struct{})
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 1,
+		CreateIndexer: func() search.SearchIndexer {
+			return &gatedIndexer{WuKongIndexer: indexer.NewWuKongIndexer(), gate: gate}
+		},
+	})
+	defer close(gate)
+
+	ticket, err := engine.IndexDocumentTicket(1, search.DocumentIndexData{Tokens: testTokens("a")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := ticket.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("文档还没有加入索引时返回%v，应为context.DeadlineExceeded", err)
+	}
+	select {
+	case <-ticket.Done():
+		t.Fatal("文档还没有加入索引时Done已经关闭")
+	default:
+	}
+
+	gate <- struct{}{}
+	if err := ticket.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"a"}}); fmt.Sprint(docIds) != "[1]" {
+		t.Errorf("凭据完成后命中%v", docIds)
+	}
+}
@@ -0,0 +1,89 @@
+package search
+
+//模糊匹配：把搜索键展开为索引中拼写相近的搜索键
+
+import "sort"
+
+// 每个搜索键最多展开的相近搜索键数，编辑距离小的优先
+const maxFuzzyExpansions = 50
+
+// 搜索键允许的最大编辑距离，不超过fuzziness，也不超过搜索键字符数的一半，
+// 避免单个汉字或者很短的单词匹配到大量无关的搜索键
+func fuzzyDistance(term string, fuzziness int) int {
+	distance := len([]rune(term)) / 2
+	if fuzziness < distance {
+		distance = fuzziness
+	}
+	return distance
+}
+
+// 编辑距离为distance的搜索键的权重，精确匹配为1，距离每增加1权重减半
+func fuzzyBoost(distance int) float32 {
+	return 1 / float32(int(1)<<uint(distance))
+}
+
+// 把查询中的搜索键展开为同义词组，包括搜索键本身和全部shard中拼写相近的搜索键
+// 短语和NOT之下的搜索键要求精确匹配，不展开
+func (engine *Engine) fuzzyQuery(query *Query, fuzziness int) *Query {
+	switch query.Type {
+	case TermQuery:
+		return engine.fuzzyTerm(query, fuzziness)
+	case AndQuery, OrQuery, SynonymQuery:
+		children := make([]*Query, 0, len(query.Children))
+		for _, child := range query.Children {
+			expanded := engine.fuzzyQuery(child, fuzziness)
+			if query.Type == SynonymQuery && expanded.Type == SynonymQuery && child.Type == TermQuery {
+				// 同义词的相近搜索键直接并入外层的同义词组
+				children = append(children, expanded.Children...)
+			} else {
+				children = append(children, expanded)
+			}
+		}
+		return &Query{Type: query.Type, Children: children}
+	}
+	return query
+}
+
+func (engine *Engine) fuzzyTerm(query *Query, fuzziness int) *Query {
+	distance := fuzzyDistance(query.Term, fuzziness)
+	if distance == 0 {
+		return query
+	}
+
+	// 合并各shard的结果，同一搜索键在各shard中的编辑距离相同
+	found := make(map[string]int)
+	for _, indexer := range engine.indexers {
+		for _, term := range indexer.FuzzyTerms(query.Term, distance) {
+			if term.Term != query.Term {
+				found[term.Term] = term.Distance
+			}
+		}
+	}
+	if len(found) == 0 {
+		return query
+	}
+	terms := make([]FuzzyTerm, 0, len(found))
+	for term, distance := range found {
+		terms = append(terms, FuzzyTerm{Term: term, Distance: distance})
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].Distance != terms[j].Distance {
+			return terms[i].Distance < terms[j].Distance
+		}
+		return terms[i].Term < terms[j].Term
+	})
+	if len(terms) > maxFuzzyExpansions {
+		terms = terms[:maxFuzzyExpansions]
+	}
+
+	boost := query.Boost
+	if boost == 0 {
+		boost = 1
+	}
+	children := []*Query{query}
+	for _, term := range terms {
+		children = append(children, &Query{
+			Type: TermQuery, Term: term.Term, Boost: boost * fuzzyBoost(term.Distance)})
+	}
+	return NewSynonymQuery(children...)
+}
@@ -0,0 +1,65 @@
+package search_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/aosen/search"
+	"github.com/aosen/search/indexer"
+)
+
+// 返回索引器中与term相近的搜索键，按字典序排列
+func fuzzyTermTexts(searchIndexer search.SearchIndexer, term string) []string {
+	var texts []string
+	for _, t := range searchIndexer.FuzzyTerms(term, 1) {
+		texts = append(texts, t.Term)
+	}
+	sort.Strings(texts)
+	return texts
+}
+
+func TestFuzzyTermsExcludeLabels(t *testing.T) {
+	wukong := indexer.NewWuKongIndexer()
+	if err := wukong.Init(search.IndexerInitOptions{IndexType: search.DocIdsIndex}); err != nil {
+		t.Fatal(err)
+	}
+	keywords := func(texts ...string) []search.KeywordIndex {
+		keywords := make([]search.KeywordIndex, len(texts))
+		for i, text := range texts {
+			keywords[i] = search.KeywordIndex{Text: text}
+		}
+		return keywords
+	}
+	wukong.AddDocument(&search.DocumentIndex{DocId: 1, Keywords: keywords("手机", "手表"), Labels: []string{"手表"}})
+	if texts := fuzzyTermTexts(wukong, "手机"); fmt.Sprint(texts) != "[手机]" {
+		t.Errorf("只作为标签时展开为%v", texts)
+	}
+
+	// 另一个文档的正文中出现该词时可以展开，该文档删除后又不能展开
+	wukong.AddDocument(&search.DocumentIndex{DocId: 2, Keywords: keywords("手表")})
+	if texts := fuzzyTermTexts(wukong, "手机"); fmt.Sprint(texts) != "[手机 手表]" {
+		t.Errorf("同时作为正文时展开为%v", texts)
+	}
+	wukong.RemoveDocument(2)
+	if texts := fuzzyTermTexts(wukong, "手机"); fmt.Sprint(texts) != "[手机]" {
+		t.Errorf("删除正文文档后展开为%v", texts)
+	}
+	wukong.RemoveDocument(1)
+	if texts := fuzzyTermTexts(wukong, "手机"); len(texts) != 0 {
+		t.Errorf("删除全部文档后展开为%v", texts)
+	}
+}
+
+func TestFuzzySearchSkipsLabels(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{Segmenter: newTestSegmenter(t)})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "华为手机"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "价格", Labels: []string{"手表"}})
+	engine.IndexDocument(3, search.DocumentIndexData{Content: "苹果电话"})
+	engine.FlushIndex()
+
+	docIds := searchDocIds(t, engine, search.SearchRequest{Text: "手机", Fuzziness: 1})
+	if fmt.Sprint(docIds) != "[1]" {
+		t.Errorf("命中%v，不应通过标签命中文档2", docIds)
+	}
+}
@@ -0,0 +1,286 @@
+package search
+
+//搜索结果的高亮和摘要
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// 高亮选项
+type HighlightOptions struct {
+	// 每个片段的长度，单位为字符（不是字节），为0时取100
+	FragmentSize int
+
+	// 每个文档最多返回的片段数，为0时取1
+	NumFragments int
+
+	// 插入在关键词前后的标签，都为空时取"<em>"和"</em>"
+	// 文档内容不做任何转义，需要时请在调用方处理
+	PreTag  string
+	PostTag string
+}
+
+// 填入默认值
+func (options *HighlightOptions) init() {
+	if options.FragmentSize <= 0 {
+		options.FragmentSize = 100
+	}
+	if options.NumFragments <= 0 {
+		options.NumFragments = 1
+	}
+	if options.PreTag == "" && options.PostTag == "" {
+		options.PreTag = "<em>"
+		options.PostTag = "</em>"
+	}
+}
+
+// 文档内容中需要高亮的一段，[start, end)为字节位置，token为关键词的下标
+type highlightSpan struct {
+	start, end int
+	token      int
+}
+
+// 为一个搜索结果生成高亮的片段
+//
+// tokens为SearchResponse.Tokens，doc.TokenLocations与之一一对应。第一个片段围绕
+// 紧邻距离最小的关键词位置（doc.TokenSnippetLocations）截取，其余片段依次选取
+// 包含最多不同关键词的窗口。没有位置信息时（IndexType不是LocationsIndex）在content中
+// 查找关键词。片段总是在字符边界上截断，不会切开多字节的中文字符。
+func Highlight(content string, tokens []string, doc *ScoredDocument, options HighlightOptions) []string {
+	if content == "" {
+		return nil
+	}
+	options.init()
+
+	occurrences := highlightSpans(content, tokens, doc.TokenLocations)
+	spans := mergeSpans(occurrences)
+
+	// 第一个片段围绕紧邻距离最小的位置
+	type window struct{ from, to int }
+	var windows []window
+	low, high := -1, -1
+	for i, start := range doc.TokenSnippetLocations {
+		if i >= len(tokens) {
+			continue
+		}
+		end := matchToken(content, tokens[i], start)
+		if end < 0 {
+			continue
+		}
+		if low < 0 || start < low {
+			low = start
+		}
+		if end > high {
+			high = end
+		}
+	}
+	if low >= 0 {
+		from, to := fragmentWindow(content, spans, low, high, options.FragmentSize)
+		windows = append(windows, window{from, to})
+	}
+
+	// 其余片段选择包含最多不同关键词的窗口，其次是关键词出现的次数，窗口之间不重叠
+	covered := func(span highlightSpan) bool {
+		for _, w := range windows {
+			if span.start < w.to && span.end > w.from {
+				return true
+			}
+		}
+		return false
+	}
+	for len(windows) < options.NumFragments {
+		best, bestDistinct, bestCount := window{}, 0, 0
+		for _, span := range spans {
+			if covered(span) {
+				continue
+			}
+			from, to := fragmentWindow(content, spans, span.start, span.end, options.FragmentSize)
+			overlapped := false
+			for _, w := range windows {
+				if from < w.to && to > w.from {
+					overlapped = true
+					break
+				}
+			}
+			if overlapped {
+				continue
+			}
+			found := make(map[int]bool)
+			count := 0
+			for _, other := range occurrences {
+				if other.start >= from && other.end <= to {
+					found[other.token] = true
+					count++
+				}
+			}
+			if len(found) > bestDistinct || (len(found) == bestDistinct && count > bestCount) {
+				best, bestDistinct, bestCount = window{from, to}, len(found), count
+			}
+		}
+		if bestCount == 0 {
+			break
+		}
+		windows = append(windows, best)
+	}
+
+	// 没有关键词时返回文档开头
+	if len(windows) == 0 {
+		to, _ := forwardRunes(content, 0, options.FragmentSize)
+		return []string{content[:to]}
+	}
+
+	fragments := make([]string, len(windows))
+	for i, w := range windows {
+		fragments[i] = tagFragment(content, spans, w.from, w.to, &options)
+	}
+	return fragments
+}
+
+// 收集关键词出现的位置，按起始位置排序
+func highlightSpans(content string, tokens []string, locations [][]int) []highlightSpan {
+	var spans []highlightSpan
+	for i, token := range tokens {
+		if token == "" {
+			continue
+		}
+		if locations == nil {
+			// 没有位置信息时在内容中逐个字符查找，与matchToken一样先归一化再比较
+			for start := 0; start < len(content); {
+				if end := matchToken(content, token, start); end >= 0 {
+					spans = append(spans, highlightSpan{start, end, i})
+					start = end
+					continue
+				}
+				_, size := utf8.DecodeRuneInString(content[start:])
+				start += size
+			}
+			continue
+		}
+		if i >= len(locations) {
+			continue
+		}
+		for _, start := range locations[i] {
+			if end := matchToken(content, token, start); end >= 0 {
+				spans = append(spans, highlightSpan{start, end, i})
+			}
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	return spans
+}
+
+// 合并重叠和相邻的位置，得到需要插入标签的各段
+func mergeSpans(spans []highlightSpan) []highlightSpan {
+	var merged []highlightSpan
+	for _, span := range spans {
+		if n := len(merged); n > 0 && span.start <= merged[n-1].end {
+			if span.end > merged[n-1].end {
+				merged[n-1].end = span.end
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
+
+// content在start处是否为token，是则返回token在content中的结束位置，否则返回-1
+// 分词得到的关键词经过归一化并且英文为小写，content中对应的文本可能是繁体、全角或者大写，
+// 长度也可能与关键词不同，因此逐个字符归一化后比较
+func matchToken(content, token string, start int) int {
+	if start < 0 || start > len(content) {
+		return -1
+	}
+	pos := start
+	for _, expected := range token {
+		if pos >= len(content) {
+			return -1
+		}
+		r, size := utf8.DecodeRuneInString(content[pos:])
+		r = NormalizeRune(r)
+		if r != expected && !(r >= 'A' && r <= 'Z' && r+'a'-'A' == expected) {
+			return -1
+		}
+		pos += size
+	}
+	return pos
+}
+
+// 围绕[low, high)截取size个字符的窗口，两边尽量留出相同的字符数
+// [low, high)超过size个字符时从low开始截取。窗口的边界落在关键词中间时收缩到
+// 关键词之外，不输出半个关键词
+func fragmentWindow(content string, spans []highlightSpan, low, high, size int) (from, to int) {
+	length := utf8.RuneCountInString(content[low:high])
+	if length >= size {
+		from = low
+		to, _ = forwardRunes(content, low, size)
+	} else {
+		extra := size - length
+		var before, after int
+		from, before = backwardRunes(content, low, extra/2)
+		to, after = forwardRunes(content, high, extra-before)
+		if before+after < extra {
+			// 后面的字符不够时向前补足
+			from, _ = backwardRunes(content, from, extra-before-after)
+		}
+	}
+
+	for _, span := range spans {
+		if span.start < from && from < span.end && span.end < to {
+			from = span.end
+		}
+		if span.start < to && to < span.end && span.start > from {
+			to = span.start
+		}
+	}
+	return from, to
+}
+
+// 从pos向后移动n个字符，返回新的位置和实际移动的字符数
+func forwardRunes(content string, pos, n int) (int, int) {
+	moved := 0
+	for moved < n && pos < len(content) {
+		_, size := utf8.DecodeRuneInString(content[pos:])
+		pos += size
+		moved++
+	}
+	return pos, moved
+}
+
+// 从pos向前移动n个字符，返回新的位置和实际移动的字符数
+func backwardRunes(content string, pos, n int) (int, int) {
+	moved := 0
+	for moved < n && pos > 0 {
+		_, size := utf8.DecodeLastRuneInString(content[:pos])
+		pos -= size
+		moved++
+	}
+	return pos, moved
+}
+
+// 在[from, to)范围内的关键词前后插入标签
+func tagFragment(content string, spans []highlightSpan, from, to int, options *HighlightOptions) string {
+	var builder strings.Builder
+	last := from
+	for _, span := range spans {
+		start, end := span.start, span.end
+		if start < from {
+			start = from
+		}
+		if end > to {
+			end = to
+		}
+		if start >= end {
+			continue
+		}
+		builder.WriteString(content[last:start])
+		builder.WriteString(options.PreTag)
+		builder.WriteString(content[start:end])
+		builder.WriteString(options.PostTag)
+		last = end
+	}
+	builder.WriteString(content[last:to])
+	return builder.String()
+}
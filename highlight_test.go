@@ -0,0 +1,36 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+func TestHighlightWithoutLocations(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		Segmenter:          newTestSegmenter(t),
+		IndexerInitOptions: &search.IndexerInitOptions{IndexType: search.FrequenciesIndex},
+		StoreContent:       true,
+	})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "新款iPhone和ｉＰｈｏｎｅ，還有華為手機"})
+	engine.FlushIndex()
+
+	options := &search.HighlightOptions{PreTag: "[", PostTag: "]"}
+	for text, want := range map[string]string{
+		"iphone": "新款[iPhone]和[ｉＰｈｏｎｅ]，還有華為手機",
+		"华为":     "新款iPhone和ｉＰｈｏｎｅ，還有[華為]手機",
+		"华为 手机":  "新款iPhone和ｉＰｈｏｎｅ，還有[華為手機]",
+	} {
+		response, err := engine.Search(search.SearchRequest{Text: text, Highlight: options})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Docs) != 1 {
+			t.Errorf("%s: 命中%v", text, response.Docs)
+			continue
+		}
+		if fragments := response.Docs[0].Fragments; len(fragments) != 1 || fragments[0] != want {
+			t.Errorf("%s: 片段为%q，应为%q", text, fragments, want)
+		}
+	}
+}
@@ -2,16 +2,94 @@ package search
 
 //搜索引擎的索引器接口,以及提供的必要结构体和方法
 
+import "context"
+
 //索引器接口
 //开发者只要实现以下接口，即可实现一个索引器
 type SearchIndexer interface {
-	// 初始化索引器
-	Init(options IndexerInitOptions)
-	// 向反向索引表中加入一个文档
+	// 初始化索引器，重复初始化时返回ErrAlreadyInitialized
+	Init(options IndexerInitOptions) error
+	// 向反向索引表中加入一个文档，docId已存在时替换原文档
 	AddDocument(document *DocumentIndex)
+	// 批量加入文档，效果同依次调用AddDocument
+	AddDocuments(documents []*DocumentIndex)
+	// 从反向索引表中删除一个文档的全部索引项，删除后的文档不能再被查找到
+	RemoveDocument(docId uint64)
 	// 查找包含全部搜索键(AND操作)的文档
 	// 当docIds不为nil时仅从docIds指定的文档中查找
 	Lookup(tokens []string, labels []string, docIds []uint64) (docs []IndexedDocument)
+	// 按查询语法树查找文档，支持AND、OR、NOT的组合
+	// 返回文档的紧邻距离和关键词位置与request.Query.Terms()一一对应
+	// ctx被取消时应尽快放弃查找，此时返回值会被丢弃
+	LookupQuery(ctx context.Context, request LookupRequest) (docs []IndexedDocument)
+	// 返回本索引器的语料统计信息，DocumentFrequencies只包含terms中的搜索键
+	CorpusStats(terms []string) CorpusStats
+	// 返回索引中与term的编辑距离不超过maxDistance的搜索键，包括term本身，用于模糊匹配
+	// 只在文档标签中出现的搜索键不应返回
+	FuzzyTerms(term string, maxDistance int) []FuzzyTerm
+}
+
+// 索引器查找请求
+type LookupRequest struct {
+	// 查询语法树，为nil时只按标签查找
+	Query *Query
+
+	// 文档标签，作为AND条件过滤文档，不参与BM25和紧邻距离的计算
+	Labels []string
+
+	// 当不为空时仅从[DocIds[0], DocIds[1]]范围内的文档中查找
+	DocIds []uint64
+
+	// 属性字段的过滤条件，全部满足的文档才会被返回，在打分之前执行
+	Filters []LookupFilter
+
+	// 需要随结果返回的属性字段下标，与RankOptions.Sort一一对应，-1表示按相关度排序
+	// 返回的取值见IndexedDocument.SortValues
+	SortFields []int
+
+	// 是否在IndexedDocument.Explanation中记录打分过程
+	Explain bool
+
+	// 是否在IndexedDocument.Labels中返回文档的标签，用于分面统计
+	// 标签必须和查找结果在同一次加锁中取得，不能看到查找之后才加入或删除的文档
+	WithLabels bool
+
+	// 计算BM25使用的语料统计信息，通常是引擎汇总全部shard得到的结果，
+	// 保证同一文档无论分配到哪个shard都得到相同的分数。
+	// 为nil时使用索引器自身的统计信息
+	Stats *CorpusStats
+}
+
+// 模糊匹配找到的搜索键
+type FuzzyTerm struct {
+	Term string
+
+	// 与原搜索键的编辑距离，按字符计算
+	Distance int
+}
+
+// 语料统计信息，用于计算BM25的idf和平均文本关键词长度
+type CorpusStats struct {
+	// 文档总数
+	NumDocuments uint64
+
+	// 所有被索引文本的总关键词数
+	TotalTokenLength float32
+
+	// 搜索键出现的文档数
+	DocumentFrequencies map[string]uint64
+}
+
+// 将另一部分语料的统计信息累加进来
+func (stats *CorpusStats) Merge(other CorpusStats) {
+	stats.NumDocuments += other.NumDocuments
+	stats.TotalTokenLength += other.TotalTokenLength
+	if stats.DocumentFrequencies == nil {
+		stats.DocumentFrequencies = make(map[string]uint64, len(other.DocumentFrequencies))
+	}
+	for term, frequency := range other.DocumentFrequencies {
+		stats.DocumentFrequencies[term] += frequency
+	}
 }
 
 // 这些常数定义了反向索引表存储的数据类型
@@ -48,6 +126,16 @@ type DocumentIndex struct {
 
 	// 加入的索引键
 	Keywords []KeywordIndex
+
+	// 文档标签，同时也作为索引键加入Keywords中
+	Labels []string
+
+	// 为中文关键词加入的拼音，同时也作为索引键加入Keywords中
+	// 不包括文档中本来就有的关键词，这些拼音不参与输入提示和模糊匹配
+	Pinyin []string
+
+	// 文档的属性字段取值，与IndexerInitOptions.Attributes一一对应，为nil时没有任何属性
+	Attributes []AttributeValue
 }
 
 type DocumentIndexData struct {
@@ -65,6 +153,10 @@ type DocumentIndexData struct {
 
 	// 文档的评分字段，可以接纳任何类型的结构体
 	Fields interface{}
+
+	// 文档的属性字段，键为EngineInitOptions.Attributes中声明的字段名，
+	// 取值的类型必须与字段类型相符，可以在搜索时按属性字段过滤
+	Attributes map[string]interface{}
 }
 
 // 索引器返回结果
@@ -79,12 +171,22 @@ type IndexedDocument struct {
 	TokenProximity int32
 
 	// 紧邻距离计算得到的关键词位置，和Lookup函数输入tokens的长度一样且一一对应。
+	// 使用OR查询时，文档中没有出现的关键词位置为-1。
 	// 仅当索引类型为LocationsIndex时返回有效值。
 	TokenSnippetLocations []int
 
-	// 关键词在文本中的具体位置。
+	// 关键词在文本中的具体位置，文档中没有出现的关键词为nil。
 	// 仅当索引类型为LocationsIndex时返回有效值。
 	TokenLocations [][]int
+
+	// 排序使用的属性字段取值，与LookupRequest.SortFields一一对应
+	SortValues []AttributeValue
+
+	// 打分过程，仅当LookupRequest.Explain为true时不为nil
+	Explanation *Explanation
+
+	// 文档的标签，仅当LookupRequest.WithLabels为true时返回，不能修改
+	Labels []string
 }
 
 // 初始化索引器选项
@@ -94,4 +196,7 @@ type IndexerInitOptions struct {
 
 	// BM25参数
 	BM25Parameters *BM25Parameters
+
+	// 属性字段的定义，由引擎按EngineInitOptions.Attributes设置
+	Attributes []AttributeField
 }
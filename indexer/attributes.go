@@ -0,0 +1,205 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/aosen/search"
+)
+
+// 文档属性字段的列式存储
+// 每个文档分配一个序号，各字段的取值按序号存放在各自的列中，
+// 删除文档后空出的序号会被新文档重用
+type attributeColumns struct {
+	fields []search.AttributeField
+
+	// 从DocId到序号
+	ordinals map[uint64]int
+
+	// 空闲的序号
+	free []int
+
+	// 与fields一一对应
+	columns []attributeColumn
+}
+
+// 一个属性字段的取值，按字段类型只使用其中一个切片
+type attributeColumn struct {
+	// 文档是否设置了该字段
+	valid []bool
+
+	ints   []int64   // Int64Attribute和TimeAttribute
+	floats []float64 // Float64Attribute
+
+	// KeywordAttribute，每个关键字编码后存放，terms记录编码对应的关键字。
+	// refs记录使用每个编码的文档数，没有文档使用的关键字从dict中删除，
+	// 编码放入freeCodes留给以后的关键字，因此字典的大小不超过现有文档中不同取值的个数
+	codes     []int32
+	dict      map[string]int32
+	terms     []string
+	refs      []int
+	freeCodes []int32
+}
+
+func newAttributeColumns(fields []search.AttributeField) *attributeColumns {
+	columns := &attributeColumns{
+		fields:   fields,
+		ordinals: make(map[uint64]int),
+		columns:  make([]attributeColumn, len(fields)),
+	}
+	for i, field := range fields {
+		if field.Type == search.KeywordAttribute {
+			columns.columns[i].dict = make(map[string]int32)
+		}
+	}
+	return columns
+}
+
+// 设置文档的属性，values为nil时删除文档的属性
+func (self *attributeColumns) set(docId uint64, values []search.AttributeValue) {
+	if len(values) == 0 {
+		self.remove(docId)
+		return
+	}
+
+	ordinal, found := self.ordinals[docId]
+	if !found {
+		ordinal = self.allocate()
+		self.ordinals[docId] = ordinal
+	}
+	for i := range self.columns {
+		column := &self.columns[i]
+		value := values[i]
+		switch self.fields[i].Type {
+		case search.Float64Attribute:
+			column.floats[ordinal] = value.Float
+		case search.KeywordAttribute:
+			// 先引用新的取值再释放旧的取值，取值不变时编码也不变
+			code := int32(0)
+			if value.Valid {
+				code = column.acquire(value.Keyword)
+			}
+			if column.valid[ordinal] {
+				column.release(column.codes[ordinal])
+			}
+			column.codes[ordinal] = code
+		default:
+			column.ints[ordinal] = value.Int
+		}
+		column.valid[ordinal] = value.Valid
+	}
+}
+
+// 一个文档引用关键字，返回关键字的编码
+func (column *attributeColumn) acquire(keyword string) int32 {
+	if code, found := column.dict[keyword]; found {
+		column.refs[code]++
+		return code
+	}
+	var code int32
+	if n := len(column.freeCodes); n > 0 {
+		code = column.freeCodes[n-1]
+		column.freeCodes = column.freeCodes[:n-1]
+		column.terms[code] = keyword
+		column.refs[code] = 1
+	} else {
+		code = int32(len(column.terms))
+		column.terms = append(column.terms, keyword)
+		column.refs = append(column.refs, 1)
+	}
+	column.dict[keyword] = code
+	return code
+}
+
+// 一个文档不再引用编码为code的关键字，没有文档引用时从字典中删除
+func (column *attributeColumn) release(code int32) {
+	column.refs[code]--
+	if column.refs[code] > 0 {
+		return
+	}
+	delete(column.dict, column.terms[code])
+	column.terms[code] = ""
+	column.freeCodes = append(column.freeCodes, code)
+}
+
+// 分配一个序号，没有空闲的序号时加长各列
+func (self *attributeColumns) allocate() int {
+	if n := len(self.free); n > 0 {
+		ordinal := self.free[n-1]
+		self.free = self.free[:n-1]
+		return ordinal
+	}
+
+	ordinal := len(self.ordinals) + len(self.free)
+	for i := range self.columns {
+		column := &self.columns[i]
+		column.valid = append(column.valid, false)
+		switch self.fields[i].Type {
+		case search.Float64Attribute:
+			column.floats = append(column.floats, 0)
+		case search.KeywordAttribute:
+			column.codes = append(column.codes, 0)
+		default:
+			column.ints = append(column.ints, 0)
+		}
+	}
+	return ordinal
+}
+
+// 删除文档的属性，空出的序号留给以后的文档
+func (self *attributeColumns) remove(docId uint64) {
+	ordinal, found := self.ordinals[docId]
+	if !found {
+		return
+	}
+	for i := range self.columns {
+		column := &self.columns[i]
+		if column.valid[ordinal] && self.fields[i].Type == search.KeywordAttribute {
+			column.release(column.codes[ordinal])
+		}
+		column.valid[ordinal] = false
+	}
+	delete(self.ordinals, docId)
+	self.free = append(self.free, ordinal)
+}
+
+// 返回文档在field字段上的取值，文档没有设置该字段时Valid为false
+func (self *attributeColumns) value(docId uint64, field int) search.AttributeValue {
+	ordinal, found := self.ordinals[docId]
+	if !found || field < 0 || field >= len(self.columns) {
+		return search.AttributeValue{}
+	}
+	column := &self.columns[field]
+	if !column.valid[ordinal] {
+		return search.AttributeValue{}
+	}
+	switch self.fields[field].Type {
+	case search.Float64Attribute:
+		return search.AttributeValue{Valid: true, Float: column.floats[ordinal]}
+	case search.KeywordAttribute:
+		return search.AttributeValue{Valid: true, Keyword: column.terms[column.codes[ordinal]]}
+	}
+	return search.AttributeValue{Valid: true, Int: column.ints[ordinal]}
+}
+
+// 返回满足全部过滤条件的文档，保持docIds中的顺序
+// docIds可能直接引用反向索引表，因此结果总是写入新的切片。ctx被取消时返回nil
+func (self *attributeColumns) filter(
+	ctx context.Context, docIds []uint64, filters []search.LookupFilter) []uint64 {
+	var result []uint64
+	for i, docId := range docIds {
+		if i%numDocsPerContextCheck == 0 && ctx.Err() != nil {
+			return nil
+		}
+		matched := true
+		for j := range filters {
+			if !filters[j].Match(self.value(docId, filters[j].Field)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			result = append(result, docId)
+		}
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package indexer
+
+import "github.com/aosen/search"
+
+// 按字符（rune）组织的搜索键字典，用于模糊匹配
+// 记录每个搜索键被多少个文档加入，与反向索引表同步更新，由tableLock保护
+type termTrie struct {
+	root *termNode
+}
+
+type termNode struct {
+	children map[rune]*termNode
+
+	// 以本节点结尾的搜索键，不是搜索键时为空
+	term string
+
+	// 加入该搜索键的文档数
+	count int
+}
+
+func newTermTrie() *termTrie {
+	return &termTrie{root: &termNode{}}
+}
+
+// 一个文档加入搜索键，空字符串被忽略
+func (trie *termTrie) add(term string) {
+	if term == "" {
+		return
+	}
+	node := trie.root
+	for _, r := range term {
+		child, found := node.children[r]
+		if !found {
+			if node.children == nil {
+				node.children = make(map[rune]*termNode)
+			}
+			child = &termNode{}
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.term = term
+	node.count++
+}
+
+// 一个文档删除搜索键，没有文档包含该搜索键时从字典中删除，
+// 同时删除不再包含任何搜索键的节点
+func (trie *termTrie) remove(term string) {
+	runes := []rune(term)
+	path := make([]*termNode, 0, len(runes)+1)
+	node := trie.root
+	path = append(path, node)
+	for _, r := range runes {
+		node = node.children[r]
+		if node == nil {
+			return
+		}
+		path = append(path, node)
+	}
+	if node.term == "" {
+		return
+	}
+	if node.count--; node.count > 0 {
+		return
+	}
+	node.term = ""
+	for i := len(path) - 1; i > 0; i-- {
+		if path[i].term != "" || len(path[i].children) > 0 {
+			break
+		}
+		delete(path[i-1].children, runes[i-1])
+	}
+}
+
+// 返回与term的编辑距离（Levenshtein距离，按字符计算）不超过maxDistance的全部搜索键
+//
+// 沿前缀树深度优先遍历，每个节点维护term与该节点前缀的编辑距离矩阵的一行，
+// 相当于在前缀树上运行Levenshtein自动机。一行中的最小值超过maxDistance时，
+// 该节点之下不可能再有满足条件的搜索键，整个子树被跳过。
+func (trie *termTrie) search(term string, maxDistance int) (terms []search.FuzzyTerm) {
+	target := []rune(term)
+	row := make([]int, len(target)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	var walk func(node *termNode, r rune, previous []int)
+	walk = func(node *termNode, r rune, previous []int) {
+		row := make([]int, len(previous))
+		row[0] = previous[0] + 1
+		minimum := row[0]
+		for i := 1; i < len(row); i++ {
+			cost := 1
+			if target[i-1] == r {
+				cost = 0
+			}
+			row[i] = minInt(row[i-1]+1, previous[i]+1, previous[i-1]+cost)
+			if row[i] < minimum {
+				minimum = row[i]
+			}
+		}
+
+		if node.term != "" && row[len(row)-1] <= maxDistance {
+			terms = append(terms, search.FuzzyTerm{Term: node.term, Distance: row[len(row)-1]})
+		}
+		if minimum <= maxDistance {
+			for r, child := range node.children {
+				walk(child, r, row)
+			}
+		}
+	}
+
+	for r, child := range trie.root.children {
+		walk(child, r, row)
+	}
+	return
+}
+
+func minInt(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
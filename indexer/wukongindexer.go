@@ -8,14 +8,18 @@ Data: 2015-01-06
 package indexer
 
 import (
-	"log"
+	"context"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/aosen/search"
 	"github.com/aosen/search/utils"
 )
 
+// 查找和计算BM25时每处理这么多文档检查一次ctx是否已被取消
+const numDocsPerContextCheck = 1024
+
 // 反向索引表的一行，收集了一个搜索键出现的所有文档，
 //按照DocId从小到大排序。
 type KeywordIndices struct {
@@ -37,7 +41,7 @@ type WuKongIndexer struct {
 	initOptions search.IndexerInitOptions
 	initialized bool
 
-	// 这实际上是总文档数的一个近似
+	// 索引中的文档总数，文档被替换或删除时同步更新
 	numDocuments uint64
 
 	// 所有被索引文本的总关键词数
@@ -45,6 +49,21 @@ type WuKongIndexer struct {
 
 	// 每个文档的关键词长度
 	docTokenLengths map[uint64]float32
+
+	// 每个文档加入的搜索键，用于替换文档时删除旧的索引项
+	docKeywords map[uint64][]string
+
+	// 每个文档的标签，用于分面统计
+	docLabels map[uint64][]string
+
+	// 文档的属性字段，用于过滤
+	attributes *attributeColumns
+
+	// 每个文档加入模糊匹配字典的搜索键，不包括标签和拼音
+	docTerms map[uint64][]string
+
+	// 反向索引表中除标签和拼音以外的搜索键组成的字典，用于模糊匹配
+	terms *termTrie
 }
 
 func NewWuKongIndexer() *WuKongIndexer {
@@ -52,39 +71,64 @@ func NewWuKongIndexer() *WuKongIndexer {
 }
 
 // 初始化索引器
-func (self *WuKongIndexer) Init(options search.IndexerInitOptions) {
+// 索引器只能初始化一次，重复初始化时返回search.ErrAlreadyInitialized
+// 尚未初始化的索引器不接受文档，查找时返回空结果
+func (self *WuKongIndexer) Init(options search.IndexerInitOptions) error {
 	if self.initialized == true {
-		log.Fatal("索引器不能初始化两次")
+		return search.ErrAlreadyInitialized
 	}
 	self.initialized = true
 
 	self.tableLock.table = make(map[string]*KeywordIndices)
 	self.initOptions = options
 	self.docTokenLengths = make(map[uint64]float32)
+	self.docKeywords = make(map[uint64][]string)
+	self.docLabels = make(map[uint64][]string)
+	self.docTerms = make(map[uint64][]string)
+	self.attributes = newAttributeColumns(options.Attributes)
+	self.terms = newTermTrie()
+	return nil
 }
 
 // 向反向索引表中加入一个文档
+// 当文档已经存在时，先删除旧文档的全部索引项再加入，即替换该文档
 func (self *WuKongIndexer) AddDocument(document *search.DocumentIndex) {
 	if self.initialized == false {
-		log.Fatal("索引器尚未初始化")
+		return
 	}
 
 	self.tableLock.Lock()
 	defer self.tableLock.Unlock()
+	self.addDocument(document)
+}
+
+// 批量加入文档，只获取一次写锁
+func (self *WuKongIndexer) AddDocuments(documents []*search.DocumentIndex) {
+	if self.initialized == false {
+		return
+	}
+
+	self.tableLock.Lock()
+	defer self.tableLock.Unlock()
+	for _, document := range documents {
+		self.addDocument(document)
+	}
+}
+
+// 加入一个文档，调用者必须持有写锁
+func (self *WuKongIndexer) addDocument(document *search.DocumentIndex) {
+	// 删除旧文档的索引项，避免不再出现的关键词仍然命中该文档
+	self.removeDocument(document.DocId)
 
 	// 更新文档关键词总长度
 	if document.TokenLength != 0 {
-		originalLength, found := self.docTokenLengths[document.DocId]
 		self.docTokenLengths[document.DocId] = float32(document.TokenLength)
-		if found {
-			self.totalTokenLength += document.TokenLength - originalLength
-		} else {
-			self.totalTokenLength += document.TokenLength
-		}
+		self.totalTokenLength += document.TokenLength
 	}
 
-	docIdIsNew := true
+	keywords := make([]string, 0, len(document.Keywords))
 	for _, keyword := range document.Keywords {
+		keywords = append(keywords, keyword.Text)
 		indices, foundKeyword := self.tableLock.table[keyword.Text]
 		if !foundKeyword {
 			// 如果没找到该搜索键则加入
@@ -104,8 +148,6 @@ func (self *WuKongIndexer) AddDocument(document *search.DocumentIndex) {
 		position, found := self.searchIndex(
 			indices, 0, self.getIndexLength(indices)-1, document.DocId)
 		if found {
-			docIdIsNew = false
-
 			// 覆盖已有的索引项
 			switch self.initOptions.IndexType {
 			case search.LocationsIndex:
@@ -133,161 +175,577 @@ func (self *WuKongIndexer) AddDocument(document *search.DocumentIndex) {
 	}
 
 	// 更新文章总数
-	if docIdIsNew {
-		self.numDocuments++
+	self.docKeywords[document.DocId] = keywords
+	if len(document.Labels) > 0 {
+		self.docLabels[document.DocId] = document.Labels
+	}
+	if terms := fuzzyTerms(document); len(terms) > 0 {
+		for _, term := range terms {
+			self.terms.add(term)
+		}
+		self.docTerms[document.DocId] = terms
+	}
+	self.attributes.set(document.DocId, document.Attributes)
+	self.numDocuments++
+}
+
+// 文档中参与模糊匹配的搜索键，不包括标签和拼音
+// 标签是精确的分类值，拼音本身已经是一种容错，都不应被输错的搜索键展开命中。
+// 同一个词在一个文档中是标签或拼音、在另一个文档中是正文的分词时，仍由后者加入字典
+func fuzzyTerms(document *search.DocumentIndex) []string {
+	excluded := make(map[string]bool, len(document.Labels)+len(document.Pinyin))
+	for _, label := range document.Labels {
+		excluded[label] = true
+	}
+	for _, pinyin := range document.Pinyin {
+		excluded[pinyin] = true
+	}
+	terms := make([]string, 0, len(document.Keywords))
+	for _, keyword := range document.Keywords {
+		if !excluded[keyword.Text] {
+			terms = append(terms, keyword.Text)
+		}
+	}
+	return terms
+}
+
+// 从反向索引表中删除一个文档
+// 同时更新文档总数和关键词长度，保证BM25的计算不受已删除文档的影响
+func (self *WuKongIndexer) RemoveDocument(docId uint64) {
+	if self.initialized == false {
+		return
+	}
+
+	self.tableLock.Lock()
+	defer self.tableLock.Unlock()
+	self.removeDocument(docId)
+}
+
+// 从反向索引表中删除一个文档的全部索引项，并更新文档总数和关键词长度
+// 调用者必须持有写锁。文档不存在时返回false
+func (self *WuKongIndexer) removeDocument(docId uint64) bool {
+	keywords, found := self.docKeywords[docId]
+	if !found {
+		return false
+	}
+
+	for _, keyword := range keywords {
+		indices, foundKeyword := self.tableLock.table[keyword]
+		if !foundKeyword {
+			continue
+		}
+		position, foundDocId := self.searchIndex(
+			indices, 0, self.getIndexLength(indices)-1, docId)
+		if !foundDocId {
+			continue
+		}
+
+		switch self.initOptions.IndexType {
+		case search.LocationsIndex:
+			indices.locations = append(indices.locations[:position], indices.locations[position+1:]...)
+		case search.FrequenciesIndex:
+			indices.frequencies = append(indices.frequencies[:position], indices.frequencies[position+1:]...)
+		}
+		indices.docIds = append(indices.docIds[:position], indices.docIds[position+1:]...)
+
+		// 搜索键不再出现于任何文档时从表中删除
+		if self.getIndexLength(indices) == 0 {
+			delete(self.tableLock.table, keyword)
+		}
 	}
+	for _, term := range self.docTerms[docId] {
+		self.terms.remove(term)
+	}
+
+	if length, found := self.docTokenLengths[docId]; found {
+		self.totalTokenLength -= length
+		delete(self.docTokenLengths, docId)
+	}
+	delete(self.docKeywords, docId)
+	delete(self.docLabels, docId)
+	delete(self.docTerms, docId)
+	self.attributes.remove(docId)
+	self.numDocuments--
+	return true
 }
 
 // 查找包含全部搜索键(AND操作)的文档
 // 当docIds不为nil时仅从docIds指定的文档中查找
 func (self *WuKongIndexer) Lookup(
-	tokens []string, labels []string, docIds []uint64 /*docIds *map[uint64]bool*/) (docs []search.IndexedDocument) {
-	if self.initialized == false {
-		log.Fatal("索引器尚未初始化")
+	tokens []string, labels []string, docIds []uint64) (docs []search.IndexedDocument) {
+	var query *search.Query
+	if len(tokens) > 0 {
+		children := make([]*search.Query, len(tokens))
+		for i, token := range tokens {
+			children[i] = search.NewTermQuery(token)
+		}
+		query = search.NewAndQuery(children...)
 	}
+	return self.LookupQuery(context.Background(),
+		search.LookupRequest{Query: query, Labels: labels, DocIds: docIds})
+}
 
-	if self.numDocuments == 0 {
+// 按查询语法树查找文档
+// 先对各搜索键的文档列表求并、交、差得到命中的文档，再对参与打分的搜索键
+// 计算BM25和紧邻距离。ctx被取消时返回nil
+func (self *WuKongIndexer) LookupQuery(
+	ctx context.Context, request search.LookupRequest) (docs []search.IndexedDocument) {
+	if self.initialized == false {
 		return
 	}
 
-	// 合并关键词和标签为搜索键
-	keywords := make([]string, len(tokens)+len(labels))
-	copy(keywords, tokens)
-	copy(keywords[len(tokens):], labels)
-
 	self.tableLock.RLock()
 	defer self.tableLock.RUnlock()
-	table := make([]*KeywordIndices, len(keywords))
-	for i, keyword := range keywords {
-		indices, found := self.tableLock.table[keyword]
+	if self.numDocuments == 0 {
+		return
+	}
+
+	// 求出满足查询条件的文档，按DocId从小到大排列
+	var matched []uint64
+	if request.Query != nil {
+		matched = self.evaluate(ctx, request.Query)
+	}
+	for i, label := range request.Labels {
+		indices, found := self.tableLock.table[label]
 		if !found {
-			// 当反向索引表中无此搜索键时直接返回
+			// 当反向索引表中无此标签时直接返回
 			return
+		}
+		if request.Query == nil && i == 0 {
+			matched = indices.docIds
 		} else {
-			// 否则加入反向表中
-			table[i] = indices
+			matched = intersectDocIds(matched, indices.docIds)
 		}
 	}
 
-	// 当没有找到时直接返回
-	if len(table) == 0 {
+	//只要判断搜索结果是否在给定范围内就ok，无需生成字典
+	if len(request.DocIds) == 2 && (request.DocIds[0] <= request.DocIds[1]) {
+		low := sort.Search(len(matched), func(i int) bool { return matched[i] >= request.DocIds[0] })
+		high := sort.Search(len(matched), func(i int) bool { return matched[i] > request.DocIds[1] })
+		matched = matched[low:high]
+	}
+
+	// 按属性字段过滤，在打分之前减少需要计算的文档
+	if len(request.Filters) > 0 && len(matched) > 0 {
+		matched = self.attributes.filter(ctx, matched, request.Filters)
+	}
+
+	// 当没有找到或者查找已被取消时直接返回
+	if len(matched) == 0 || ctx.Err() != nil {
 		return
 	}
 
-	// 归并查找各个搜索键出现文档的交集
-	// 从后向前查保证先输出DocId较大文档
-	indexPointers := make([]int, len(table))
-	for iTable := 0; iTable < len(table); iTable++ {
-		indexPointers[iTable] = self.getIndexLength(table[iTable]) - 1
-	}
-	// 平均文本关键词长度，用于计算BM25
-	avgDocLength := self.totalTokenLength / float32(self.numDocuments)
-	for ; indexPointers[0] >= 0; indexPointers[0]-- {
-		// 以第一个搜索键出现的文档作为基准，并遍历其他搜索键搜索同一文档
-		baseDocId := self.getDocId(table[0], indexPointers[0])
-
-		/*
-			if docIds != nil {
-				_, found := (*docIds)[baseDocId]
-				if !found {
-					continue
+	// 参与打分的搜索键，文档中未出现的搜索键不计入BM25
+	var (
+		terms  []string
+		boosts []float32
+		groups [][][]int
+	)
+	if request.Query != nil {
+		terms = request.Query.Terms()
+		boosts = request.Query.TermBoosts()
+		groups = request.Query.SynonymGroups()
+	}
+	table := make([]*KeywordIndices, len(terms))
+	for i, term := range terms {
+		table[i] = self.tableLock.table[term]
+	}
+
+	// 计算BM25使用的统计信息，优先使用请求中的全局统计信息
+	stats := bm25Stats{
+		numDocuments:        float64(self.numDocuments),
+		avgDocLength:        self.totalTokenLength / float32(self.numDocuments),
+		documentFrequencies: make([]uint64, len(terms)),
+		boosts:              boosts,
+	}
+	if request.Stats != nil && request.Stats.NumDocuments > 0 {
+		stats.numDocuments = float64(request.Stats.NumDocuments)
+		stats.avgDocLength = request.Stats.TotalTokenLength / float32(request.Stats.NumDocuments)
+	}
+	for i, term := range terms {
+		if request.Stats != nil {
+			if frequency, found := request.Stats.DocumentFrequencies[term]; found {
+				stats.documentFrequencies[i] = frequency
+				continue
+			}
+		}
+		if table[i] != nil {
+			stats.documentFrequencies[i] = uint64(self.getIndexLength(table[i]))
+		}
+	}
+
+	// 从后向前输出保证先输出DocId较大文档
+	docs = make([]search.IndexedDocument, 0, len(matched))
+	for i := len(matched) - 1; i >= 0; i-- {
+		if i%numDocsPerContextCheck == 0 && ctx.Err() != nil {
+			return nil
+		}
+		doc := self.scoreDocument(matched[i], terms, table, groups, &stats, request.Explain)
+		if len(request.SortFields) > 0 {
+			doc.SortValues = make([]search.AttributeValue, len(request.SortFields))
+			for j, field := range request.SortFields {
+				doc.SortValues[j] = self.attributes.value(doc.DocId, field)
+			}
+		}
+		if request.WithLabels {
+			doc.Labels = self.docLabels[doc.DocId]
+		}
+		docs = append(docs, doc)
+	}
+	return
+}
+
+// 返回本索引器的语料统计信息
+func (self *WuKongIndexer) CorpusStats(terms []string) search.CorpusStats {
+	if self.initialized == false {
+		return search.CorpusStats{}
+	}
+
+	self.tableLock.RLock()
+	defer self.tableLock.RUnlock()
+	stats := search.CorpusStats{
+		NumDocuments:        self.numDocuments,
+		TotalTokenLength:    self.totalTokenLength,
+		DocumentFrequencies: make(map[string]uint64, len(terms)),
+	}
+	for _, term := range terms {
+		if indices, found := self.tableLock.table[term]; found {
+			stats.DocumentFrequencies[term] = uint64(self.getIndexLength(indices))
+		} else {
+			stats.DocumentFrequencies[term] = 0
+		}
+	}
+	return stats
+}
+
+// 返回索引中与term的编辑距离不超过maxDistance的搜索键，包括term本身
+func (self *WuKongIndexer) FuzzyTerms(term string, maxDistance int) []search.FuzzyTerm {
+	if self.initialized == false {
+		return nil
+	}
+
+	self.tableLock.RLock()
+	defer self.tableLock.RUnlock()
+	return self.terms.search(term, maxDistance)
+}
+
+// 求出满足查询的全部文档，返回按DocId升序排列的列表
+// 返回值可能直接引用反向索引表，调用者不能修改，并且必须持有读锁
+func (self *WuKongIndexer) evaluate(ctx context.Context, query *search.Query) []uint64 {
+	// 查找被取消时不再继续求集合运算
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	switch query.Type {
+	case search.TermQuery:
+		if indices, found := self.tableLock.table[query.Term]; found {
+			return indices.docIds
+		}
+	case search.AndQuery:
+		// 嵌套的AND展开到同一层，比如 a (-b -c) 中的排除条件也从a的结果中减去
+		var positives [][]uint64
+		var negatives []*search.Query
+		var collect func(query *search.Query)
+		collect = func(query *search.Query) {
+			for _, child := range query.Children {
+				switch child.Type {
+				case search.NotQuery:
+					negatives = append(negatives, child.Children[0])
+				case search.AndQuery:
+					collect(child)
+				default:
+					positives = append(positives, self.evaluate(ctx, child))
 				}
 			}
-		*/
-		//注释上方，只要判断搜索结果是否在给定范围内就ok，无需生成字典
-		//大大提高搜索效率
-		if len(docIds) == 2 && (docIds[0] <= docIds[1]) {
-			if docIds[0] > baseDocId || baseDocId > docIds[1] {
+		}
+		collect(query)
+		// 只有排除条件时不命中任何文档
+		if len(positives) == 0 {
+			return nil
+		}
+
+		// 从最短的文档列表开始求交集
+		sort.Slice(positives, func(i, j int) bool { return len(positives[i]) < len(positives[j]) })
+		result := positives[0]
+		for _, docIds := range positives[1:] {
+			if len(result) == 0 {
+				return nil
+			}
+			result = intersectDocIds(result, docIds)
+		}
+		for _, negative := range negatives {
+			if len(result) == 0 {
+				return nil
+			}
+			result = subtractDocIds(result, self.evaluate(ctx, negative))
+		}
+		return result
+	case search.PhraseQuery:
+		// 先求出包含全部关键词的文档
+		docIds := self.evaluate(ctx, search.NewAndQuery(query.Children...))
+		if self.initOptions.IndexType != search.LocationsIndex || len(docIds) == 0 {
+			// 没有位置信息时短语查询退化为AND查询
+			return docIds
+		}
+		return self.filterPhrase(ctx, query, docIds)
+	case search.OrQuery, search.SynonymQuery:
+		var result []uint64
+		for _, child := range query.Children {
+			// OR中的排除条件无法求值，引擎在搜索前已经拒绝了这样的查询
+			if child.Type == search.NotQuery {
 				continue
 			}
+			result = unionDocIds(result, self.evaluate(ctx, child))
 		}
+		return result
+	}
+	// 单独的NOT查询不命中任何文档
+	return nil
+}
 
-		iTable := 1
+// 从包含短语全部关键词的文档中，找出关键词按短语中的顺序出现、
+// 间隔不超过query.Slop的文档，间隔见phraseGap的注释
+func (self *WuKongIndexer) filterPhrase(ctx context.Context, query *search.Query, docIds []uint64) []uint64 {
+	tokens := make([]string, len(query.Children))
+	table := make([]*KeywordIndices, len(query.Children))
+	for i, child := range query.Children {
+		tokens[i] = child.Term
+		table[i] = self.tableLock.table[child.Term]
+	}
+
+	result := make([]uint64, 0, len(docIds))
+	indexPointers := make([]int, len(table))
+	for iDoc, docId := range docIds {
+		if iDoc%numDocsPerContextCheck == 0 && ctx.Err() != nil {
+			return nil
+		}
 		found := true
-		for ; iTable < len(table); iTable++ {
-			// 二分法比简单的顺序归并效率高，也有更高效率的算法，
-			// 但顺序归并也许是更好的选择，考虑到将来需要用链表重新实现
-			// 以避免反向表添加新文档时的写锁。
-			// TODO: 进一步研究不同求交集算法的速度和可扩展性。
-			position, foundBaseDocId := self.searchIndex(table[iTable],
-				0, indexPointers[iTable], baseDocId)
-			if foundBaseDocId {
-				indexPointers[iTable] = position
-			} else {
-				if position == 0 {
-					// 该搜索键中所有的文档ID都比baseDocId大，因此已经没有
-					// 继续查找的必要。
-					return
-				} else {
-					// 继续下一indexPointers[0]的查找
-					indexPointers[iTable] = position - 1
-					found = false
-					break
-				}
+		for i, indices := range table {
+			position, foundDocId := self.searchIndex(indices, 0, self.getIndexLength(indices)-1, docId)
+			if !foundDocId || len(indices.locations[position]) == 0 {
+				found = false
+				break
 			}
+			indexPointers[i] = position
+		}
+		if !found {
+			continue
 		}
 
-		if found {
-			indexedDoc := search.IndexedDocument{}
+		if gap := phraseGap(table, indexPointers, tokens); gap >= 0 && gap <= query.Slop {
+			result = append(result, docId)
+		}
+	}
+	return result
+}
 
-			// 当为LocationsIndex时计算关键词紧邻距离
-			if self.initOptions.IndexType == search.LocationsIndex {
-				// 计算有多少关键词是带有距离信息的
-				numTokensWithLocations := 0
-				for i, t := range table[:len(tokens)] {
-					if len(t.locations[indexPointers[i]]) > 0 {
-						numTokensWithLocations++
-					}
+// 计算短语中的关键词按顺序出现时的最小间隔
+//
+// 假定第 i 个搜索键首字节出现在文本中的位置为 P_i，长度 L_i，要求
+// P_(i+1) >= P_i + L_i，即后一个关键词在前一个关键词结束之后出现，间隔为
+//
+//	Min(Sum(P_(i+1) - P_i - L_i))
+//
+// 与computeTokenProximity不同，顺序颠倒的位置不计算在内。关键词无法按顺序出现时返回-1
+func phraseGap(table []*KeywordIndices, indexPointers []int, tokens []string) int {
+	// gaps[j]为前 i 个关键词以第 i 个关键词的第 j 个位置结尾时的最小间隔，-1表示无法按顺序出现
+	previous := table[0].locations[indexPointers[0]]
+	gaps := make([]int, len(previous))
+	for i := 1; i < len(tokens); i++ {
+		locations := table[i].locations[indexPointers[i]]
+		nextGaps := make([]int, len(locations))
+		length := len(tokens[i-1])
+
+		// 位置按升序排列，依次把结束位置不超过当前位置的前一个关键词位置加入候选，
+		// 候选中 gaps[k] - P_k - L 的最小值加上当前位置即为最小间隔
+		found, best, k := false, 0, 0
+		for j, location := range locations {
+			for ; k < len(previous) && previous[k]+length <= location; k++ {
+				if gaps[k] < 0 {
+					continue
 				}
-				if numTokensWithLocations != len(tokens) {
-					docs = append(docs, search.IndexedDocument{
-						DocId: baseDocId,
-					})
-					break
+				if value := gaps[k] - previous[k] - length; !found || value < best {
+					found, best = true, value
 				}
+			}
+			if found {
+				nextGaps[j] = best + location
+			} else {
+				nextGaps[j] = -1
+			}
+		}
+		previous, gaps = locations, nextGaps
+	}
 
-				// 计算搜索键在文档中的紧邻距离
-				tokenProximity, tokenLocations := computeTokenProximity(table[:len(tokens)], indexPointers, tokens)
-				indexedDoc.TokenProximity = int32(tokenProximity)
-				indexedDoc.TokenSnippetLocations = tokenLocations
+	minGap := -1
+	for _, gap := range gaps {
+		if gap >= 0 && (minGap < 0 || gap < minGap) {
+			minGap = gap
+		}
+	}
+	return minGap
+}
 
-				// 添加TokenLocations
-				indexedDoc.TokenLocations = make([][]int, len(tokens))
-				for i, t := range table[:len(tokens)] {
-					indexedDoc.TokenLocations[i] = t.locations[indexPointers[i]]
-				}
+// 计算BM25使用的语料统计信息
+type bm25Stats struct {
+	// 文档总数
+	numDocuments float64
+
+	// 平均文本关键词长度
+	avgDocLength float32
+
+	// 每个参与打分的搜索键出现的文档数
+	documentFrequencies []uint64
+
+	// 每个参与打分的搜索键的权重，见Query.TermBoosts
+	boosts []float32
+}
+
+// 计算一个命中文档的BM25和紧邻距离
+// terms为参与打分的搜索键，table为对应的反向索引表行，搜索键不存在时为nil，
+// groups为查询中的同义词组，见Query.SynonymGroups。explain为true时记录打分过程
+func (self *WuKongIndexer) scoreDocument(docId uint64, terms []string, table []*KeywordIndices,
+	groups [][][]int, stats *bm25Stats, explain bool) search.IndexedDocument {
+	indexedDoc := search.IndexedDocument{DocId: docId}
+	var explanation *search.Explanation
+	if explain {
+		explanation = &search.Explanation{
+			NumDocuments: uint64(stats.numDocuments),
+			AvgDocLength: stats.avgDocLength,
+			DocLength:    self.docTokenLengths[docId],
+			Terms:        make([]search.TermExplanation, len(terms)),
+		}
+		if self.initOptions.BM25Parameters != nil {
+			explanation.K1 = self.initOptions.BM25Parameters.K1
+			explanation.B = self.initOptions.BM25Parameters.B
+		}
+		for i, term := range terms {
+			explanation.Terms[i] = search.TermExplanation{
+				Term: term, Boost: stats.boosts[i], DocumentFrequency: stats.documentFrequencies[i]}
+		}
+		indexedDoc.Explanation = explanation
+	}
+
+	// 找到文档在每个搜索键中的索引项位置，文档中没有该搜索键时为-1
+	positions := make([]int, len(terms))
+	for i, indices := range table {
+		positions[i] = -1
+		if indices == nil {
+			continue
+		}
+		position, found := self.searchIndex(indices, 0, self.getIndexLength(indices)-1, docId)
+		if found {
+			positions[i] = position
+		}
+	}
+
+	// 当为LocationsIndex时计算关键词紧邻距离
+	if self.initOptions.IndexType == search.LocationsIndex {
+		indexedDoc.TokenLocations = make([][]int, len(terms))
+		indexedDoc.TokenSnippetLocations = make([]int, len(terms))
+
+		// 只有带有位置信息的关键词参与紧邻距离的计算
+		var (
+			proximityTable    []*KeywordIndices
+			proximityPointers []int
+			proximityTokens   []string
+			proximityIndices  []int
+		)
+		for i := range terms {
+			indexedDoc.TokenSnippetLocations[i] = -1
+			if positions[i] < 0 {
+				continue
+			}
+			indexedDoc.TokenLocations[i] = table[i].locations[positions[i]]
+			if len(indexedDoc.TokenLocations[i]) > 0 {
+				proximityTable = append(proximityTable, table[i])
+				proximityPointers = append(proximityPointers, positions[i])
+				proximityTokens = append(proximityTokens, terms[i])
+				proximityIndices = append(proximityIndices, i)
 			}
+		}
 
-			// 当为LocationsIndex或者FrequenciesIndex时计算BM25
-			if self.initOptions.IndexType == search.LocationsIndex ||
-				self.initOptions.IndexType == search.FrequenciesIndex {
-				bm25 := float32(0)
-				d := self.docTokenLengths[baseDocId]
-				for i, t := range table[:len(tokens)] {
-					var frequency float32
-					if self.initOptions.IndexType == search.LocationsIndex {
-						frequency = float32(len(t.locations[indexPointers[i]]))
-					} else {
-						frequency = t.frequencies[indexPointers[i]]
-					}
-
-					// 计算BM25
-					if len(t.docIds) > 0 && frequency > 0 && self.initOptions.BM25Parameters != nil && avgDocLength != 0 {
-						// 带平滑的idf
-						idf := float32(math.Log2(float64(self.numDocuments)/float64(len(t.docIds)) + 1))
-						k1 := self.initOptions.BM25Parameters.K1
-						b := self.initOptions.BM25Parameters.B
-						bm25 += idf * frequency * (k1 + 1) / (frequency + k1*(1-b+b*d/avgDocLength))
-					}
+		// 计算搜索键在文档中的紧邻距离
+		if len(proximityTable) > 0 {
+			tokenProximity, tokenLocations := computeTokenProximity(
+				proximityTable, proximityPointers, proximityTokens)
+			indexedDoc.TokenProximity = int32(tokenProximity)
+			for j, i := range proximityIndices {
+				indexedDoc.TokenSnippetLocations[i] = tokenLocations[j]
+			}
+		}
+	}
+
+	// 当为LocationsIndex或者FrequenciesIndex时计算BM25
+	if self.initOptions.IndexType == search.LocationsIndex ||
+		self.initOptions.IndexType == search.FrequenciesIndex {
+		scores := make([]float32, len(terms))
+		d := self.docTokenLengths[docId]
+		for i, t := range table {
+			if positions[i] < 0 {
+				continue
+			}
+			var frequency float32
+			if self.initOptions.IndexType == search.LocationsIndex {
+				frequency = float32(len(t.locations[positions[i]]))
+			} else {
+				frequency = t.frequencies[positions[i]]
+			}
+
+			// 计算BM25
+			df := stats.documentFrequencies[i]
+			if df > 0 && frequency > 0 && self.initOptions.BM25Parameters != nil && stats.avgDocLength != 0 {
+				// 带平滑的idf
+				idf := float32(math.Log2(stats.numDocuments/float64(df) + 1))
+				k1 := self.initOptions.BM25Parameters.K1
+				b := self.initOptions.BM25Parameters.B
+				norm := 1 - b + b*d/stats.avgDocLength
+				score := stats.boosts[i] * idf * frequency * (k1 + 1) / (frequency + k1*norm)
+				scores[i] = score
+				if explanation != nil {
+					explanation.Terms[i].IDF = idf
+					explanation.Terms[i].LengthNorm = norm
+					explanation.Terms[i].Score = score
 				}
-				indexedDoc.BM25 = float32(bm25)
 			}
+			if explanation != nil {
+				explanation.Terms[i].Frequency = frequency
+			}
+		}
+		indexedDoc.BM25 = combineScores(scores, groups)
+	}
+	if explanation != nil {
+		explanation.TokenProximity = indexedDoc.TokenProximity
+		explanation.BM25 = indexedDoc.BM25
+	}
+	return indexedDoc
+}
 
-			indexedDoc.DocId = baseDocId
-			docs = append(docs, indexedDoc)
+// 将各搜索键的得分相加，每个同义词组只计得分最高的一个子查询
+func combineScores(scores []float32, groups [][][]int) float32 {
+	bm25 := float32(0)
+	grouped := make([]bool, len(scores))
+	for _, group := range groups {
+		best := float32(0)
+		for _, alternative := range group {
+			sum := float32(0)
+			for _, i := range alternative {
+				sum += scores[i]
+				grouped[i] = true
+			}
+			if sum > best {
+				best = sum
+			}
 		}
+		bm25 += best
 	}
-	return
+	for i, score := range scores {
+		if !grouped[i] {
+			bm25 += score
+		}
+	}
+	return bm25
 }
 
 // 二分法查找indices中某文档的索引项
@@ -421,3 +879,70 @@ func computeTokenProximity(table []*KeywordIndices, indexPointers []int, tokens
 	}
 	return
 }
+
+// 求两个升序文档列表的交集
+// 遍历较短的列表，并在较长的列表中二分查找
+func intersectDocIds(a, b []uint64) []uint64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	result := make([]uint64, 0, len(a))
+	start := 0
+	for _, docId := range a {
+		start += sort.Search(len(b)-start, func(i int) bool { return b[start+i] >= docId })
+		if start == len(b) {
+			break
+		}
+		if b[start] == docId {
+			result = append(result, docId)
+		}
+	}
+	return result
+}
+
+// 求两个升序文档列表的并集
+func unionDocIds(a, b []uint64) []uint64 {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+	result := make([]uint64, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			result = append(result, a[i])
+			i++
+		case a[i] > b[j]:
+			result = append(result, b[j])
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+	result = append(result, a[i:]...)
+	return append(result, b[j:]...)
+}
+
+// 从升序文档列表a中去掉出现在b中的文档
+func subtractDocIds(a, b []uint64) []uint64 {
+	if len(b) == 0 {
+		return a
+	}
+	result := make([]uint64, 0, len(a))
+	j := 0
+	for _, docId := range a {
+		for j < len(b) && b[j] < docId {
+			j++
+		}
+		if j < len(b) && b[j] == docId {
+			continue
+		}
+		result = append(result, docId)
+	}
+	return result
+}
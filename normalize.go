@@ -0,0 +1,58 @@
+package search
+
+//字符归一化：繁体转简体，全角转半角
+//分词器的字元划分（SplitTextToWords）、词典载入、文档索引和搜索都经过同样的归一化，
+//繁体和简体、全角和半角的文本可以互相搜到
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// 繁体字到简体字的映射
+var simplifiedRunes = parseSimplifiedTable(simplifiedTable)
+
+func parseSimplifiedTable(table string) map[rune]rune {
+	runes := make(map[rune]rune, utf8.RuneCountInString(table)/2)
+	var traditional rune
+	for _, r := range table {
+		if r == '\n' {
+			continue
+		}
+		if traditional == 0 {
+			traditional = r
+			continue
+		}
+		runes[traditional] = r
+		traditional = 0
+	}
+	return runes
+}
+
+// 归一化一个字符：全角字符转为对应的半角字符，全角空格转为半角空格，繁体字转为简体字
+func NormalizeRune(r rune) rune {
+	switch {
+	case r == '　':
+		return ' '
+	case r >= '！' && r <= '～':
+		return r - 0xFEE0
+	}
+	if simplified, found := simplifiedRunes[r]; found {
+		return simplified
+	}
+	return r
+}
+
+// 对文本中的每个字符做NormalizeRune，不改变英文大小写
+func NormalizeText(text string) string {
+	return strings.Map(NormalizeRune, text)
+}
+
+// 对每个关键词做NormalizeText，返回新的切片
+func normalizeTokens(tokens []string) []string {
+	normalized := make([]string, len(tokens))
+	for i, token := range tokens {
+		normalized[i] = NormalizeText(token)
+	}
+	return normalized
+}
@@ -0,0 +1,129 @@
+package search
+
+//拼音搜索：为中文关键词加入拼音，使拼音输入可以搜到中文文档
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 汉字转拼音接口，内置的实现见pinyin.NewPinyinTable
+type SearchPinyin interface {
+	// 返回汉字的全部读音，为不带声调的小写拼音（ü写作v），常用读音排在前面
+	// 不是汉字或者没有收录时返回nil
+	Pinyin(r rune) []string
+}
+
+const (
+	// 多音字每个字最多使用的读音数
+	maxPinyinReadings = 3
+
+	// 每个关键词最多生成的全拼组合数
+	maxPinyinCombinations = 16
+)
+
+// 返回中文关键词的全拼和首字母，多音字的各种读音组合都会列出
+// 关键词中有非汉字或者没有收录的字时返回nil。单字不生成首字母，避免一个字母命中大量文档
+func pinyinTokens(converter SearchPinyin, token string) (tokens []string) {
+	runes := []rune(token)
+	readings := make([][]string, len(runes))
+	for i, r := range runes {
+		if !unicode.Is(unicode.Han, r) {
+			return nil
+		}
+		readings[i] = converter.Pinyin(r)
+		if len(readings[i]) == 0 {
+			return nil
+		}
+		if len(readings[i]) > maxPinyinReadings {
+			readings[i] = readings[i][:maxPinyinReadings]
+		}
+	}
+
+	// 按读音的常用程度依次列出组合，最常用的组合在最前面
+	combinations := [][]string{{}}
+	for _, choices := range readings {
+		next := make([][]string, 0, len(combinations)*len(choices))
+		for _, choice := range choices {
+			for _, combination := range combinations {
+				if len(next) == maxPinyinCombinations {
+					break
+				}
+				next = append(next, append(append([]string{}, combination...), choice))
+			}
+		}
+		combinations = next
+	}
+
+	seen := make(map[string]bool)
+	add := func(text string) {
+		if !seen[text] {
+			seen[text] = true
+			tokens = append(tokens, text)
+		}
+	}
+	for _, combination := range combinations {
+		add(strings.Join(combination, ""))
+	}
+	if len(runes) > 1 {
+		for _, combination := range combinations {
+			var initials strings.Builder
+			for _, syllable := range combination {
+				initials.WriteByte(syllable[0])
+			}
+			add(initials.String())
+		}
+	}
+	return
+}
+
+// 在建立索引时为中文关键词加入全拼和首字母，位置与中文关键词相同
+// 返回新加入的拼音，文档中本来就有的关键词不算在内
+func (engine *Engine) indexPinyin(tokensMap map[string][]int) (pinyin []string) {
+	if engine.initOptions.Pinyin == nil {
+		return nil
+	}
+	added := make(map[string][]int)
+	for token, locations := range tokensMap {
+		for _, t := range pinyinTokens(engine.initOptions.Pinyin, token) {
+			added[t] = append(added[t], locations...)
+		}
+	}
+	for t := range added {
+		if _, found := tokensMap[t]; !found {
+			pinyin = append(pinyin, t)
+		}
+	}
+	mergeLocations(tokensMap, added)
+	return
+}
+
+// 对搜索键分词，启用拼音搜索时按空白切分，只由英文字母组成的部分是拼音输入，
+// 转为小写后不经过分词直接作为关键词，匹配索引中的拼音，其余部分照常分词
+func (engine *Engine) tokenizePinyin(term string) (tokens []string) {
+	if engine.initOptions.Pinyin == nil {
+		return engine.tokenize(term)
+	}
+	for _, field := range strings.Fields(NormalizeText(term)) {
+		if !isPinyinInput(field) {
+			tokens = append(tokens, engine.tokenize(field)...)
+		} else if token := strings.ToLower(field); !engine.stopTokens.IsStopToken(token) {
+			tokens = append(tokens, token)
+		}
+	}
+	return
+}
+
+// 搜索键是否为拼音输入，即只由英文字母组成
+func isPinyinInput(term string) bool {
+	if term == "" {
+		return false
+	}
+	for i := 0; i < len(term); i++ {
+		c := term[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package pinyin
+
+//内置拼音表的汉字转拼音
+
+import (
+	"strings"
+	"sync"
+)
+
+// 从内置拼音表查询汉字读音，实现search.SearchPinyin接口
+type PinyinTable struct {
+	// 下标为字符减去firstRune，没有收录的字为nil
+	readings [][]string
+}
+
+var (
+	defaultTable *PinyinTable
+	parseOnce    sync.Once
+)
+
+// 返回内置的拼音表，拼音表只在第一次调用时解析，之后各引擎共用
+func NewPinyinTable() *PinyinTable {
+	parseOnce.Do(func() {
+		defaultTable = parseTable(table)
+	})
+	return defaultTable
+}
+
+func parseTable(text string) *PinyinTable {
+	var readings [][]string
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		entries := strings.Fields(line)
+		for i := 0; i < runesPerLine; i++ {
+			if i >= len(entries) || entries[i] == "-" {
+				readings = append(readings, nil)
+				continue
+			}
+			readings = append(readings, strings.Split(entries[i], ","))
+		}
+	}
+	return &PinyinTable{readings: readings}
+}
+
+// 返回汉字的全部读音，为不带声调的小写拼音，多音字的常用读音排在前面
+// 不是汉字或者没有收录时返回nil。返回的切片不能修改
+func (self *PinyinTable) Pinyin(r rune) []string {
+	index := int(r) - firstRune
+	if index < 0 || index >= len(self.readings) {
+		return nil
+	}
+	return self.readings[index]
+}
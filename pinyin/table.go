@@ -0,0 +1,1324 @@
+package pinyin
+
+// 汉字拼音表，覆盖CJK统一汉字基本区（U+4E00至U+9FFF）
+// 由github.com/mozillazg/go-pinyin（MIT许可）的数据生成：去掉声调，ü写作v，
+// 多音字的读音按常用程度排列，用逗号分隔。每行16个字，从U+4E00开始依次排列，
+// 没有收录的字为"-"
+
+const firstRune = 0x4E00
+
+const runesPerLine = 16
+
+const table = `yi ding,zheng kao,qiao,yu qi shang xia han wan,mo zhang san shang xia ji,qi bu,fou,fu yu mian
+gai chou chou zhuan qie,ju,cu pi shi shi qiu bing ye cong dong si cheng,sheng,zheng diu
+qiu liang diu you liang yan bing,ban,bang sang gun jiu ge,gan ya qiang zhong ji jie
+feng guan,kuang chuan,guan,quan chan,chuan lin zhuo zhu ba wan dan wei zhu jing,dan li ju pie,yi
+fu yi,ji yi,ai nai,ai wu jiu jiu tuo,zhe me,yao,mo,ma yi yi zhi,zhu wu zha,zuo hu fa
+le,yue yin,pan,zhong ping pang qiao hu guai cheng cheng,sheng yi,jue yin ya mie,nie jiu qi ye,yi
+xi xiang gai jiu xia hu shu dou shi ji nang jia ju shi mao hu
+mai luan zi ru xue yan fu sha na gan suo yu cui zhe qian,gan zhi,luan
+gui gan luan lin yi jue le,liao ma yu,zhu zheng shi shi,zi er chu yu,wei,xu kui,yu
+yu yun hu qi wu jing si sui gen,xuan,geng gen,geng ya xie,suo ya qi,zhai ya,e ji,qi
+tou wang,wu kang,gang,geng da jiao hai,jie yi chan heng,xiang,peng mu ye xiang jing ting liang xiang
+jing ye qin,qing bo you xie dan,chan,zhan lian duo wei,men ren ren ji ji wang yi
+shen,shi ren le,li ding ze jin,fu,nu pu chou,qiu,ju ba zhang jin jie,ge bing reng cong,zong fo
+san lun bing cang zai,zi shi ta,tuo zhang fu xian xian tuo,duo,cha,zhe hong tong ren qian
+gan,han ge,yi,wu bo dai ling,lian yi,si chao chang sa chang yi mu men ren fan chao,miao
+yang,ang qian,jing zhong pi,bi wo wu jian,mou jia,jie yao,fo feng cang ren,lin wang fen,bin di fang,pang
+zhong qi pei yu,xu diao dun wu yi xin,lin kang,gang yi ji,fan ai wu ji,zhi,qi fu
+fa xiu,xu jin,yin pi dan fu tang zhong,yin you huo hui,kuai yu cui yun san wei
+chuan,zhuan che ya qian,xian shang chang lun cang,chen xun xin wei zhu ze xian nu bo,bai,mo,ba
+gu ni ni xie ban,pan xu ling zhou shen qu,zu ci,si beng shi,si ga,jia,qie pi yi
+si yi,ai,si,chi zheng dian,tian han,gan mai dan,tan,yan zhu bu qu,qia bi zhao,shao ci wei,li di zhu
+zuo you yang ti,ben,cui zhan,chan,dian he bi tuo,yi she yu,tu,xu yi,die fu,fo,bo,bi zuo gou,kou,ju ning tong
+ni xian qu yong wa qian shi ka bao pei hui,huai he,ge lao,liao xiang ge,e yang
+bai,mo fa ming jia er,nai bing ji hen,heng huo gui quan tiao,diao,yao,dao,zhao jiao,xiao ci yi shi
+xing shen tuo kan zhi gai,hai lai yi chi kua,hua,e,wu guang li,lie yin shi mi zhu,zhou
+xu you an lu mou,mao er lun dong,tong cha chi xun gong zhou yi ru cun,jian
+xia si dai lv ta jiao,yao zhen ce,ze,zhai qiao kuai chai ning nong jin wu hou
+jiong cheng,ting zhen,chen zuo chou qin lv ju shu,dou ting shen tui,tuo bo nan xiao bian,pian
+tui yu xi cu,chuo e qiu xu,shu guang ku wu jun,shun,dun yi fu liang,lang zu qiao,xiao
+li yong hun jing,ying qian,xian san pei su fu xi li fu,mian ping bao yu,shu qi,si
+xia xin,shen xiu yu di che,ju chou zhi yan lia,liang li lai si jian xiu fu
+huo ju xiao pai jian biao chu,shu,ti fei feng,beng ya an,yan bei yu xin bi,bei,pi hu,chi
+chang,cheng,zheng zhi bing jiu yao cui,zu lia,liang wan lai,lie cang,chuang zong ge guan bei,pei tian shu
+shu men dao tan,dan jue chui,zhui xing peng,ping tang,chang hou yi,ji qi ti,diao,zhou gan jing,liang jie
+sui chang jie,qie fang zhi kong juan zong ju qian,qing ni,nie lun zhuo wo,wei luo song
+leng,ling hun dong zi ben wu ju nai cai jian zhai ye zhi sha qing ning
+ying cheng qian yan ruan,ru zhong,chong,tong chun jia,jie,xia,ge ji,jie,qi wei yu bing ruo,re ti wei pian
+yan feng tang,dang wo e xie,jie che sheng kan di zuo cha ting bei xie,ye,zha huang
+yao zhan chou,qiao,zou yan you jian xu zha ci fu bi,fu zhi zong,cong mian ji yi
+xie xun cai,si duan ce,ze,zhai zhen,zheng ou tou tou bei za,zan lou,lv jie wei,e,gui fen chang
+gui,kui,kuai sou zhi,si su xia fu yuan rong li nu yun jiang,gou ma bang,pang,beng,peng dian tang
+hao jie xi shan qian,jian jue,que cang,cheng,chen chu san bei xiao yong,rong yao tan,ta suo yang
+fa bing jia,xiang dai zai tang gu bin chu nuo can,san,ca,sen lei cui yong,chong zao,cao zong
+beng,peng song,shuang ao chuan,zhuan yu zhai zu,qi shang chuang jing chi sha han zhang qing yan,yin
+di xie,su lou,liu,lv bei piao,biao jin lian lu,liao man qian xian tan,lan ying dong zhuan,zun xiang
+shan qiao,jiao jiong tui zun,cuan pu,bu xi lao chang guang liao,lao qi cheng,deng,teng chan,zhuan wei ji
+bo hui chuan,chun tie,jian dan,chan,shan,da jiao,yao jiu seng,ceng fen xian ju,yu e jiao jian,zen tong,zhuang,chong lin
+bo gu xian su xian jiang min ye jin jia,qia,jie qiao pi feng zhou ai sai
+yi jun nong chan,shan,tan,dan,zhan yi dang jing xuan kuai jian chu dan,shan jiao sha zai can
+bin an ru tai chou,dao chai lan ni,yi,ai jin qian meng wu ning qiong ni chang
+lie,la lei lv kuang bao yu,di,du biao zan zhi si you hao qing chen,qin li teng
+wei long chu chan rang,xiang shu,tiao hui,xie li luo zan nuo tang,chang yan lei,luo nang er,ren
+wu yun,yuan zan yuan xiong,kuang chong zhao xiong xian guang dui ke dui mian,wen,wan tu chang
+er dui,rui,duo er,ni jin,zan tu,chan si yan yan shi - dang qian dou fen mao shen
+dou - jing li huang ru wang nei quan liang yu,shu,zhu ba gong liu,lu xi han
+lan gong,hong tian guan xing bing qi,ji ju dian,tian zi,ci fen yang jian shou ji yi
+ji chan jiong mao ran nei,na,rui yuan mao gang ran,nan,dan ce jiong ce,zha zai gua jiong
+mao zhou mao,mo gou xu mian mi rong yin,you xie kan jun nong yi mi shi
+guan meng zhong ju yuan ming,mian kou lin fu xie mi bing dong tai gang feng,ping
+bing,ning hu chong jue hu kuang ye leng,ling pan fu min dong xian,sheng lie qia jian
+jing,cheng sou mei tu qi gu zhun song jing liang qing diao ling dong gan jian
+yin cou ai li chuang,cang ming zhun cui si duo jin lin lin ning xi du
+ji fan fan fan feng ju chu zheng feng mu zhi fu feng ping feng kai
+huang kai gan deng ping qian,kan xiong kuai tu ao,wa chu ji dang han han zao,zuo
+dao,diao diao dao ren ren chuang fen qie,qi yi ji kan qian cun chu wen ji
+dan xing hua,guo,huai wan jue li yue lie,li liu ze gang chuang fu chu qu diao
+shan min ling zhong pan bie jie jie pao,bao li shan bie chan jing gua geng
+dao chuang kui ku,kou duo er zhi shua quan,xuan sha,cha ci,qi ke,kei jie gui ci gui
+kai duo ji ti jing lou,dou luo ze yuan cuo xue,xiao,qiao,shao ke,kei la qian,jian sha chuang
+gua jian cuo li ti fei pou,po chan qi chuang zi gang wan bo ji duo,chi
+qing,lve shan,yan du,zhuo jian ji bo,bao,pu yan ju huo sheng jian duo,du duan,tuan,zhi wu gua fu,pi
+sheng jian ge da,zha kai,ai chuang,qiang chuan chan tuan,zhuan lu,jiu li peng shan piao,biao kou jiao,chao
+gua qiao jue hua,huai zha zhuo lian ju pi liu gui jiao,chao gui jian jian tang
+huo,hua ji jian yi jian zhi chan jian,zuan mo,mi li zhu li ya quan ban gong
+jia wu mai lie jin keng xie,lie zhi dong zhu,chu nu jie qu shao yi zhu
+mo li jin,jing lao lao juan kou yang wa xiao mou kuang jie lie he,kai shi
+ke jin,jing gao bo min chi lang yong yong mian ke xun juan qing lu bu
+meng chi,lai lei,le kai mian dong xu,mao xu kan wu,mao yi xun weng,yang sheng lao,liao mu,bo
+lu piao shi ji qin,qi jiang,qiang chao,jiao quan xiang yi jue fan juan tong,dong ju dan
+xie mai xun xun lv li che rang,xiang quan bao shao,shuo,zhuo,di yun jiu bao gou wu,mo
+yun,jun wen xiong gai gai bao,pao,fu cong yi xiong peng ju tao,yao ge pu e pao
+fu gong da jiu gong bi,pin hua,huo bei nao shi,chi fang jiu yi za jiang kang
+jiang kuang,wang hu xia qu fan gui qie zang,cang kuang fei,fen hu yu gui kui,gui hui
+dan gui,kui lian lian suan du jiu jue xi pi qu,ou yi ke,e,an yan bian ni,te
+qu,ou,gou,qiu,kou shi xun qian nian sa zu sheng wu hui ban,pan shi xi wan hua xie
+wan bei,bi,pi,ban zu,cu,cui zhuo xie dan,chan,shan mai nan,na dan ji,chi bo shuai bo,bu,pu kuang,guan bian,pan bu,ji
+zhan,tie ka,qia lu you lu,xi xi gua wo xie jie jie wei ang,yang qiong zhi mao
+yin,yi wei shao ji que luan,kun chi juan,quan,gun,jun xie xu,su jin que,jiao,xi wu ji e qing
+xi san chang,han,yan,an wei,yan e ting li zhe,zhai han,an li ya ya yan she di,zhi zha,zhai
+pang ya qie ya,ai zhi,shi ce,si pang,mang ti li,chan she hou ting zui cuo,ji fei yuan
+ce yuan xiang yan li jue sha,xia dian chu jiu jin ao gui yan,ya,yi si li
+chang lan,qian li,lai yan yan yuan si,mou gong,hong lin,min rou,qiu qu qu er lei du xian
+zhuan,hui san can,cen,shen can,shen,san,cen can can ai dai you cha ji you shuang fan shou guai
+ba fa ruo shi,li shu zhuo,yi,li,jue qu shou,dao bian xu jia,xia pan sou ji wei sou,xiao
+die rui cong kou gu,ku ju,gou,qu ling gua dao,tao kou zhi jiao zhao,shao ba,pa ding ke,ge
+tai,yi,si chi,hua,e shi you qiu po ye,xie hao,xiao si,ci tan,yi,you chi le,li diao ji,jiao liao hong
+mie xu,yu mang chi,qi ge xuan,song yao zi,ji he,ge ji diao cun,dou,ying tong ming hou li
+tu xiang zha xia,he,ha ye lv ya,a ma ou huo yi,xi jun chou lin tun,tian yin,jin
+fei bi,pi qin qin jie,ge,xie bu,pou fou,pi ba,pa dun,tun fen,pen e,hua han ting,yin,yi keng,hang shun qi
+hong zhi,zi,qi yin,shen wu,yu wu,tun chao,miao na xue,chuo,jue xi chui dou,ru wen hou hong,ou,hou wu,yu,ya gao
+ya,xia jun lv e,ai ge mei,wen dai,bao,ai qi,men cheng,kuang wu gao,ju,gu fu jiao hong chi,ying sheng
+na,ne,nuo tun wu,m yi dai,tai ou li bei,bai yuan,yun guo wen qiang wu e shi juan
+pen wen,min ne,ni m,mou ling ran you di zhou shi zhou tie,che xi,chi yi qi,zhi ping
+zi,ci,ji,xi gu,gua ci,zi wei,mei xu,hou,gou,gu he,ha,a,ke,huo nao,na,nu ga,xia,jia pei yi,chi xiao,hao shen hu,xiao,xu,he,xia ming da,ya,ta,dan qu,ka
+ju,zui gan,han,xian za tuo duo pou pao bie,bi fu yang he za,ze,zha he,hu,huo hai,tai jiu,gao yong
+fu da zhou wa ka,nong gu ka,ga,jia zuo bu long dong ning ta si xian huo
+qi er e guang,gong zha xi,die,zhi yi,xi lie zi mie mi,mie,mai zhi yao,jiao ji,xi,qia zhou,zhu,ru ge,ka,lo,luo
+shu,xun zan,za xiao ke,hai,gai hui,hai kua huai,shi,guo,gua,hua tao,tiao xian,jian e,an,n xuan xiu,xu,xiao guo,wai,he,wo,gua yan,ye,yuan lao yi
+ai pin shen tong hong xiong,hong duo,chi,zha,die wa,gui,hua ha,he,ta,sha zai you die,di pai,gu xiang ai gen,hen,n
+kuang,qiang ya da xiao bi hui,yue nian hua xing kuai duo fen ji nong mou yo
+hao yuan,yun long pou mang ge o,e chi,xia,he shao,sao,xiao li,mai,ying na,ne,nuo,nai,nie,nei zu he ku xiao,xue xian
+lao bo,po,bei,ba zhe zha liang,lang ba mie lie,lv sui fu bu,fu han heng,hng geng,ying,ng,n shuo,yue ge
+you yan gu gu bei,bai han suo,shua chun,zhen yi ai jia,qian tu xian,yan,dan wan li xi,xie
+tang zuo,shi qiu che wu,ng,m,n zao ya dou qi di qin ma,mai mo gong,hong dou qu
+lao liang,ying suo zao huan lang sha ji,jie zu wo,wei feng,beng jin,yin hu,xiao,guo,xia,hao qi shou,shu wei
+shua chang er,wa li qiang an,ng,n ze,jie yo,yu nian,dian yu tian lai sha,qie xi tuo hu
+ai zhao,zhou,dao,tiao,diao nou ken zhuo,zhou zhuo,zhao shang di,shi,zhai heng,e,za lin,lan,len a,e cai,xiao xiang,qiang tun,zhun,xiang,tui,dui wu wen
+cui,zu,za,e,chuai sha,za,jie,die,ti gu qi qi tao dan dan ye,wa zi,ci bi,tu cui chuai,chuo,zhuo he ya,e qi
+zhe fei,pei,pai,bai liang,ying xian pi sha la ze ying,qing gua pa zhe se zhuan nie guo
+luo yan di quan,jue chan,tan bo ding lang xiao ju tang chi,di ti an jiu dan
+ka,ke yong,yu wei nan shan yu zhe la jie,xie hou han,kan,jian die,zha,qie zhou chai wai nuo,re
+yu yin za,zan yao o,wo,wu mian hu yun chuan hui,zhou huan huan,yuan,xuan,he xi,chi he,ye,kai ji kui,huai
+zhong,chong wei sha,che xu huang duo,zha nie,yi xuan liang yu sang chi,kai qiao,jiao yan dan,chan,shan,zhan,tan pen,ben
+can,sun,qi li yo zha,cha wei miao ying pen bu kui xi yu jie lou ku zao,qiao
+hu ti yao he,xiao,hu a,sha,xia xiu qiang,cheng se yong su hong,gong xie ai,yi,wo suo,shuo ma cha
+hai ke,he,xia da,ta sang chen,tian ru sou,su wa,gu ji pang,beng,bang wu qian,xian,qie shi ge zi jie,jue
+lao weng wa si chi hao suo - hai,hei suo qin nie he zhi sai n,ng
+ge na die,dia ai qiang tong bi ao ao lian zui,sui zhe,zhu mo sou,shuo,shu sou tan
+di,zhe qi,zu,za jiao chong jiao,dao kai,ge tan shan,can,shen cao jia ai xiao piao lou ga gu,jia
+xiao,jiao,lao,bao,miu hu hui guo ou,xu,chu xian ze chang xu,shi po de,dei,dai ma ma hu lei,le du
+ga tang ye beng ying sai jiao mi xiao hua mai ran chuai,zuo peng lao,chao,xiao xiao,chi
+ji zhu chao,zhao kui zui xiao si hao fu,wu,m liao qiao xi chu,xu,shou chan,tan,tuo,dan dan,tan hei,mo,mu
+xun e,wu,wo zun fan,bo chi hui zan,can chuang cu,za,he dan yu tun,kuo ceng,cheng jiao,jiu ye,yi,sha xi
+qi hao lian xu deng hui yin pu jue qin xun nie lu si yan ying
+da zhan,dan o,yu,ao zhou,zhuo,zhu,du jin nong,nang yue,hui xie qi e zao yi,ai shi jiao,qiao,chi yuan ai
+yong jue,xue kuai,guai,kuo,wei yu pen,fen dao ga,ge hm,xin,hen dun dang xin sai pi pi yin zui
+ning di lan,han ta huo,wo,o ru hao xia,he ye duo pi,xi,xiu chou,zhou ji,jie,zhai jin hao ti
+chang xun me ca,cha ti,zhi lu hui bo,pao,bao you nie,yao yin hu,yo me,mei,ma hong zhe li
+liu hai nang xiao,ao mo yan li lu long mo dan chen pin pi xiang huo,xue
+mo xi duo ku yan chan ying rang dian la ta xiao jue,jiao chuo huan huo
+zhuan nie,zhe xiao,ao ca,zha,za li chan chai li yi luo nang za,zan,can su xi zen jian
+za,nie,yan,e zhu lan nie nang lan lo wei,guo hui yin qiu si nin jian,nan,yue hui xin
+yin nan,nie tuan,qiu tuan dun,tun kang yuan jiong pian yun cong hu hui yuan,wan e guo
+kun cong,chuang tong tu wei lun guo qun ri ling gu guo tai guo tu you
+guo yin hun,huan pu yu han yuan lun quan,juan yu qing guo chuan,chui wei yuan quan
+ku pu yuan yuan ya tu tu tu tuan,chuan lve hui yi huan,yuan luan luan tu,du,cha
+ya tu ting sheng,ku pu lu kuai ya zai wei,xu,yu ge,yi yu,tuo,zhun wu gui pi yi
+di,de qian,su qian zhen,quan,chou,huai zhuo dang qia xia shan kuang chang qi,yin nie mo ji,jie jia
+zhi zhi ban xun yi qin mei,fen jun,yun rong,keng tun,dun fang ben,fen ben tan kan huai,pi,pei
+zuo keng,kang bi jing,xing di,lan jing ji kuai,yue di jing jian tan li ba wu fen
+zhui po ban,pan tang kun qu,ju tan zhi tuo,yi gan ping dian,zhen gua,wa ni tai pi,huai
+jiong yang fo ao,you lu qiu mu,mei ke,jiong gou xue ba chi,di che ling zhu fu
+hu zhi chui,zhui la long long lu ao dai pao min xing dong,tong ji he lv
+ci chi lei gai yin hou dui zhao fu guang yao duo duo gui cha yang
+yin,ken fa gou yuan die xie ken,yin shang,jiong shou e,sheng bing dian hong ya kua da
+ka dang kai hang nao an xing xian yuan,huan bang fu,fou,pei,pou ba,bei yi yin han,an xu
+chui qin geng ai,zhi beng,feng fang,di que,jue yong jun jia,xia di mai,man lang juan cheng shan,yan
+jin,qin zhe lie lie pu,bu cheng hua bu shi xun guo jiong ye nian,dian,nie di yu
+bu ya,e,wu quan,juan sui,su pi,bi,bei qing,zheng wan ju lun zheng,cheng kong chong,tang,shang dong dai tan an,yan
+cai chu,tou beng,bang kan,xian zhi duo yi,shi zhi yi pei,pou,pi ji zhun,dui,guo qi sao ju ni,ban
+ku ke tang kun ni jian dui,zui jin,qin gang yu e,ya peng,beng,ping gu tu leng fang
+ya qian kun an shen duo,hui nao tu cheng yin hun bi lian guo,wo die zhuan
+hou bao,bu,pu bao yu di,ti,shi,wei mao,mou,wu jie ruan,nuo ye,e,ai geng kan,chen zong yu huang e yao
+yan bao,fu ci,ji mei chang,shang,dang du,zhe tuo yin,pou feng zhong jie jin heng gang chun jian,kan,xian
+ping lei xiang,jiang huang leng duan wan xuan ji,xi ji kuai ying ta,da cheng yong kai
+su su shi mi ta,da weng cheng tu,du tang que,qiao zhong li zhong,peng bang sai,se zang
+dui tian wu zheng xun ge zhen ai gong yan kan tian,chen,zhen yuan wen xie liu
+hai lang chang,shang peng beng chen lu lu ou qian,jian mei mo zhuan,tuan shuang shu lou
+chi man biao jing ce shu,ye zhi,di zhang kan yong dian chen zhi,zhuo xi guo qiang
+jin,qin di shang mu cui yan ta zeng qian qiang liang wei zhui qiao zeng,ceng xu
+shan,chan shan ba,fei pu kuai,tui dong,tuan fan que,qiao mo,mei dun dun zun,cun di sheng duo,hui duo
+tan deng mu,wu fen huang tan da ye zhu jian ao qiang ji qiao,ao ken yi,tu
+pi bi dian jiang ye yong,weng xue,jue,bo tan,shan,dan lan ju huai dang rang qian xun xian,lan
+xi he,huo ai ya dao hao ruan jin lei,lv kuang lu yan tan wei huai,hui long
+long rui li lin rang chan xun yan lei ba wan shi ren san zhuang zhuang
+sheng,qing yi mai ke,qiao zhu zhuang hu hu kun yi,yin hu xu kun shou mang zun
+shou yi zhi,zhong gu,ying chu jiang feng,pang bei zhai bian sui qun ling fu cuo xia,jia
+xiong,xuan xie nao xia kui xi,yi wai yuan,wan mao,wan su duo duo ye qing wai gou
+gou qi meng meng yin huo chen da,dai,tai ze tian tai,ta fu guai,jue yao,wo,wai yang,ying hang,ben
+gao shi,yi tao,ben tai tou yan,tao bi yi kua jia,ga duo hua kuang yun jia,xie,xia,ga ba
+en lian huan di,ti yan pao juan qi,ji,ai,yi nai feng xie,lie,xi,pi fen,kang dian quan kui zou,cou
+huan qi,xie,qie,jie kai zha,she,chi ben,fen yi jiang tao zang,zhuang ben xi huang fei diao xun beng,keng
+dian,ting,ding,zheng,zun ao,xiao she weng ha,po,tai ao,yu,you wu ao jiang lian duo,dui yun jiang shi fen huo
+bi luan duo,che nv,ru nu ding,tian nai qian jian,gan ta,jie,chi jiu nuan cha hao xian fan
+ji shuo,yue ru fei,pei wang hong zhuang fu ma dan ren fu,you jing yan hai,jie wen
+zhong pa du ji keng,hang zhong yao,jiao jin,xian yun miao fou,pei,pi chi yue,jue zhuang niu,hao yan
+na,nan xin fen bi yu tuo feng wan,yuan fang wu yu gui du ba,bo ni zhou,chou
+zhuo zhao da ni,nai yuan tou xian,xuan,xu zhi,yi e mei mo qi bi shen qie e
+he xu fa zheng min ban mu fu ling zi zi shi ran shan,xian,pan yang man
+jie,ju,xu,zu gu si xing,sheng wei zi,ci ju shan pin ren yao,tiao,tao dong jiang shu ji gai
+xiang hua,huo juan jiao,xiao gou lao,mu jian jian yi nian zhi ji,zhen ji,yi xian heng guang
+jun,xun,xuan,xin kua,hu yan ming lie pei e,ya you yan cha shen,xian yin shi,ti,ji gui,wa quan zi
+song wei hong wa,gui lou ya rao jiao luan ping,pin xian,dan shao li cheng,sheng xie mang
+fu suo mei,mu,wu wei ke chuo,cu,lai chuo,cu ting,tian niang xing nan yu na,nuo pou,bi nei,sui juan
+shen zhi han di zhuang e pin tui xian mian,wan,wen wu,yu yan wu ai,xi yan yu
+si yu wa li xian ju qu,ju,shu zhui,shui qi xian zhuo dong chang lu ai,e e
+e lou,lv,lei mian cong pou,pei,bu ju po cai ling wan biao xiao shu qi hui fan,fu
+wo rui,wo,nei tan fei fei jie,qie tian ni quan,juan jing hun jing qian,jin dian xing hu
+wan,guan lai bi yin chou,zhou nao,chuo fu jing lun an,nve lan kun,hun yin ya ju li
+dian xian hua hua ying chan shen ting dang,yang yao wu,mou,mu nan chuo,ruo jia tou xu
+yu wei di,ti rou mei dan ruan,nen,nun qin hui wo qian chun miao fu jie duan
+yi,xi zhong mei huang mian an,yan,e ying xuan jie wei mei yuan zheng qiu shi,ti,zhi,dai xie
+tuo,duo,nuo lian mao ran si pian wei wa cu hu ao,yun,wo jie bao xu tou,yu gui
+chu,zou yao pi,bi xi yuan ying,sheng rong ru chi liu mei pan ao ma gou kui,chou
+qin,shen jia sao zhen yuan jie,suo rong ming,meng ying,xing ji su niao xian tao pang,bang lang
+nao bao ai pi pin yi piao,biao yu,kou lei xuan man,yuan yi zhang kang yong ni
+li di gui,zui yan jin zhuan,tuan chang ze,ce han,nan nen lao mo zhe hu hu ao
+nen qiang ma pie gu wu qiao,jiao tuo zhan miao xian xian mo liao,lao lian hua
+gui deng zhi xu yi hua xi kui rao,yao xi yan chan jiao mei fan,fu fan
+xian,yan,jin yi hui jiao fu shi bi shan,chan sui qiang lian huan,xuan,qiong xin niao dong yi
+can ai niang ning ma tiao,diao chou jin ci yu pin rong ru,nou nai,er,ni yan tai
+ying qian niao yue ying mian bi ma,mo shen xing ni du liu yuan lan yan
+shuang ling jiao niang,rang lan qian,xian ying shuang hui,xie quan,huan mi li luan,lian yan zhu,shu,chuo lan
+zi jie jue jue kong yun ma,zi zi cun sun fu bei,bo zi xiao xin meng
+si tai bao ji gu nu xue you zhuan,ni hai luan sun,xun nao mie cong qian
+shu can,chan,jian,zhan ya zi ni,yi fu zi li xue,hua,jiao bo ru nai nie nie ying luan
+mian ning,zhu rong ta,tuo,yi gui zhai,che,du qiong yu shou an tu,jia song wan,kuan rou yao hong
+yi jing zhun mi,fu zhu dang hong zong guan zhou ding wan,yuan,yun,yu yi bao shi shi
+chong shen ke,qia xuan shi you huan yi tiao shi xian,xiong gong cheng qun gong xiao
+zai zha bao,shi hai,he yan xiao jia,jie,gu shen chen rong,yong huang mi kou kuan bin su,xiu,qi
+cai zan ji yuan ji yin mi kou qing he zhen jian fu ning bing huan
+mei qin han yu shi ning jin ning zhi,tian yu bao kuan ning qin mo cha,cui
+ju,lv,lou gua qin hu wu liao shi,zhi ning zhai,se,qian shen,pan wei xie kuan hui liao jun
+huan,xian yi yi bao qin chong,long bao feng cun dui si,shi xun,xin dao lv,lve dui shou
+po feng,bian zhuan fu,bu,po she,ye,yi ke,kei jiang,qiang jiang,qiang,yang zhuan,tuan,shuan wei,yu,yun zun xun,xin shu,zhu dui dao xiao
+jie,ji shao er er er ga jian shu chen shang shang,chang mo ga chang liao xian
+xian kun you,wang wang you liao,niao liao yao mang,meng,pang wang wang wang ga yao duo kui
+zhong jiu gan gu gan tui,zhuai gan gan shi yin,yun chi,che kao ni jin wei,yi niao,sui
+ju pi ceng xi bi ju,ji jie tian qu,jue,que,ju ti jie wu diao shi shi,xi ping,bing
+ji xie zhen xie ni zhan xi wei man e lou ping ti fei shu,zhu xie,ti
+tu lv lv xi ceng lv ju xie ju jue liao jue shu,zhu xi che,cao tun,zhun
+ni,po,ji shan wa xian li e,yan hui hui long,hong yi,ge qi ren wu han,an shen yu
+chu sui qi,kai ren yue ban yao ang ya,xia wu jie e,ji ji qian fen,cha wan
+qi cen qian qi cha jie qu gang xian ao lan dao ba zuo zuo yang
+ju gang ke gou xue po li tiao qu,ju,zu yan fu xiu jia ling tuo pi
+ao dai kuang yue qu hu po min an tiao ling chi ping dong han kui
+xiu mao tong xue yi bian he ba,ke luo e fu,nie xun die lu en er
+gai quan dong,tong yi mu shi an wei huan zhi,shi mi li,lie ji tong wei you
+qia xia li yao jiao,qiao zheng luan jiao e e yu xie,ye bu qiao qun feng
+feng nao li you xian rong dao shen cheng tu geng jun gao xia yin yu,wu
+lang kan lao lai xian que kong chong chong ta lin hua ju lai qi,yi min
+kun kun zu,cui gu cui ya ya gang lun lun leng,ling jue,yu duo zheng guo yin
+dong han zheng wei xiao,yao pi,bi yan song jie beng zu ku,jue dong zhan gu yin
+zi ze huang yu wai,wei yang,dang feng qiu yang ti yi zhi shi,die zai yao e
+zhu kan,zhan lv yan mei han ji ji,xi huan ting sheng,cheng mei qian,han,kan wu,mao yu zong
+lan ke,jie yan,nie yan wei zong cha sui rong ke qin yu qi lou tu dui
+xi weng cang dang,tang rong,ying jie kai,ai liu wu song qiao,kao zi wei beng dian cuo,ci
+qian yong nie cuo ji shi ruo song zong jiang liao,jiao kang chan die,di cen,can ding
+tu lou zhang zhan zhan,chan ao cao qu qiang cui,zui zui dao dao xi yu pei,pi
+long xiang ceng,zheng bo qin jiao yan lao zhan lin liao liao jin,qin deng duo zun
+jiao,qiao gui,jue yao jiao yao jue zhan,shan yi xue nao ye ye yi nie xian,yan ji
+xie,jie ke xi di ao zui wei yi,ni rong dao ling jie yu,xu yue yin ru
+jie li,lie gui,xi,juan long long dian rong,hong,ying xi ju chan ying kui,wei yan wei nao quan
+chao cuan luan dian dian nie yan yan yan kui,nao yan chuan,shun kuai,huan chuan zhou huang
+jing,xing xun,yan,shun chao chao lie gong zuo qiao ju,qu gong ju wu pu pu cha,chai,ci,cuo,jie qiu
+qiu ji,qi yi,si si,yi ba zhi zhao xiang,hang yi jin xun juan ba xun,zhuan jin fu,po
+za bi,yin shi,fu bu ding shuai fan nie shi fen pa zhi xi hu dan wei
+zhang tang,nu dai mo,wa pei,pi pa,mo tie bo,fu lian,chen zhi zhou bo zhi di mo yi
+yi ping qia juan ru shuai dai zhen,zheng shui qiao zhen shi qun xi bang dai
+gui chou,dao ping zhang san,jian wan dai wei chang sha,qie qi,ji ze guo mao du hou
+zheng xu mi wei wo fu,bi yi,kai bang ping die gong pan huang tao mi jia
+teng hui zhong shan,shen,qiao man mu,man biao guo ze,ce mu bang zhang jing chan fu zhi
+hu,wu fan chuang,zhuang bi bi zhang mi qiao chan fen meng bang chou,dao mie chu jie
+xian lan gan,an ping,pian,bing,beng nian,ning jian,qian bing bing xing,nie gan,han,guan yao,mi huan you,yao you ji,qi guang,yan,an
+pi ting ze guang zhuang,peng mo qing bi,pi qin dun,tun chuang gui ya bai,xin,ting jie xu
+lu wu zhuang ku ying di,de pao dian ya miao geng ci fu tong pang fei
+xiang yi zhi tiao zhi xiu du,duo,zhai zuo xiao tu gui ku mang,meng ting you bu
+bing cheng lai bi,pi ji an,yan,e shu,zhu,zhe kang yong tuo song shu qing yu yu miao
+sou ce,ci,ze,si xiang fei jiu e gui,wei,hui liu sha,xia lian lang sou zhi bu qing jiu
+jiu jin,qin ao kuo lou yin liao dai lu yi chu chan tu si xin,qian miao
+chang wu fei guang,kuang ku kuai bi qiang,se xie lin,lan lin liao lu,lv ji ying xian
+ting yong li ting yin xun yan ting di pai,po jian hui nai hui gong nian
+kai bian,pan yi qi nong,long fen ju,qu yan,nan yi zang bi yi yi er san shi,te
+er shi shi gong diao,di yin hu fu hong wu tui chi jiang ba shen di,ti,tui
+zhang jue,zhang tao fu di mi xian hu chao nu jing zhen yi mi quan,juan wan
+shao ruo xuan,yuan jing diao zhang jiang qiang,jiang peng dan,tan qiang,jiang bi bi she dan jian
+gou,kou ge fa bi kou jian bie xiao dan,tan guo jiang,qiang hong mi,ni guo wan jue
+ji ji gui dang lu lu tuan,shi hui,sui zhi hui hui yi yi yi yi yue
+yue shan,xian xing wen tong yan yan,pan yu chi cai biao diao bin,ban peng,pang,bang yong piao,miao
+zhang ying chi chi,fu zhuo,bo tuo,yi ji pang,fang zhong yi wang che bi di ling fu
+wang zheng cu wang jing dai xi xun hen yang huai,hui lv hou wang,wa cheng,zheng zhi
+xu jing tu cong zhi lai cong de,dei pai xi,si dong ji chang zhi cong,zong zhou
+lai yu,ya xie jie jian shi,ti jia,xia bian,pian huang fu xun wei pang,bang yao wei xi
+zheng piao ti,chi de zheng zheng,zhi,cheng bie de chong,zhong che jiao hui jiao,yao hui mei long
+xiang,rang bao qu,ju xin xin bi yi le ren dao ding,ting gai ji ren ren chan,qian
+tan,keng te,dao te,tui,tei gan,han qi,yi shi,tai cun zhi wang mang xi,lie fan ying tian min,wen wen
+zhong chong wu ji wu xi jia you wan cong song,zhong kuai yu,shu bian zhi,qi qi,shi
+cui chen,dan tai tun,zhun,dun qian,qin nian hun xiong niu kuang,wang xian xin kang,hang hu kai,qi fen
+huai,fu tai song wu ou chang chuang ju yi bao chao min,men pei zuo,zha zen yang
+ju,kou ban nu nao,niu zheng pa,bo bu tie,zhan hu,gu hu,tie ju,qu,cu,zu da,dan lian,ling si,sai chou,you di
+dai,yi yi tu,die,tui you fu ji peng xing yuan,yun ni guai fu,fei,bei xi bi you,yao qie
+xuan cong bing huang xu,xue chu,xu bi,pi shu xi tan yong zong dui mo zhi yi
+shi nen,ren,nin xun,shun shi,zhi xi lao heng,geng kuang mou zhi xie lian tiao,yao huang,guang die hao
+kong gui,wei heng xi,qi,xu jiao,xiao shu si hu,kua qiu yang hui hui chi jia,qi yi xiong
+guai lin hui zi xu chi shang nv hen en ke dong,tong tian gong quan,zhuan xi
+qia yue peng ken de hui e,wu xiao tong yan kai ce nao yun mang yong,tong
+yong yuan,juan pi,bi kun qiao yue yu,shu tu,yu jie,ke xi zhe lin ti han hao,jiao qie
+ti bu yi qian hui xi bei man,men yi heng song quan,xun cheng kui,li wu wu
+you li liang,lang huan cong yi yue li nin nao e que xuan qian wu min
+cong fei bei de cui chang men li ji guan guan xing dao qi kong tian
+lun xi kan gun ni qing chou,qiu,dao dun guo zhan jing,liang wan yuan,wan,yu jin ji lan,lin
+yu,xu huo he quan,juan tan,dan ti ti nie wang chuo,chui hu hun,men xi chang,tang xin wei
+hui e,wu,hu suo,rui zong jian yong dian ju can cheng de bei qie can dan guan
+duo,tuo nao yun xiang zhui,chuan,gua die,tie huang chun qiong re,ruo xing ce bian min,hun zong ti,shi
+qiao,qiu chou,qiao,jiu bei xuan wei ge qian wei yu yu,tou bi xuan huan min,fen bi yi
+mian yong kai,qi,he dang,shang,tang,yang yin e chen,dan,xin mao qia,ke ke yu ai qie yan nuo gan,han
+yun,wen zong sai,si leng fen ying kui kui que gong,hong yun su su,se qi yao song
+huang ji gu ju chuang ni xie kai zheng yong cao xun shen bo kai,xi,qi yuan
+xi,xie hun yong yang li sao,cao tao yin ci xu,chu qian,qie,xian tai huang yun shen,zhen ming
+gong she cong,cao piao mu mu guo chi can can can cui min te,ni zhang tong
+ao shuang man guan que zao,cao jiu hui kai lian ou song qin,jin yin lv shang
+wei tuan man qian,xian she,zhe yong qing,qiang kang di,chi zhi,zhe lou,lv juan qi qi yu ping
+liao cong,song you chong zhi tong cheng qi qu peng bei bie qiong jiao zeng chi
+lian ping kui hui qiao cheng,zheng,deng yin,xin yin xi xi dan,da,chan tan duo dui dui,dun,tun su
+jue ce xiao,jiao fan fen lao lao chong,zhuang han qi xian min jing liao wu can
+jue cu xian tan sheng pi yi chu xian nao,nong,nang dan tan jing song han,dan jiao,ji
+wei xuan,huan dong qin qin ju cao,sao ken xie ying ao,yu mao yi lin se jun
+huai men lan ai lin,lan yan,ye kuo xia chi yu yin dai meng ai,ni meng dui
+qi,ji mo lan,xian men chou zhi nuo nuo yan yang bo zhi kuang kuang you fu
+liu mie cheng hui chan meng lan,lai huai xuan rang chan ji ju huan,guan she yi
+lian nan mi,mo tang jue gang gang,zhuang zhuang,gang ge yue wu jian xu,qu shu rong,reng xi,hu
+cheng wo jie ge jian,can qiang,zang huo,yu qiang zhan dong qi,cu jia,ga die zei jia ji
+zhi kan,zhen ji kui gai deng zhan qiang,chuang ge jian jie yu jian yan,you lu hu,xi
+zhan xi xi,hu,hui,suo,yi chuo dai qu hu hu hu e shi,yi ti mao hu li fang,pang
+suo bian,pian dian jiong shang,jiong yi yi shan hu fei yan shou shou cai,zai zha,za qiu
+le,li,cai pu,pi ba,pa,bai,bie da reng fan ru zai tuo zhang diao,di,yue,li kang,gang yu,wu ku,wu gan,han shen
+cha,chai,zha tuo,yi,chi gu,qi,jie,ge kou wu den qian zhi ren kuo men sao yang niu,chou,zhou ban,fen,huo che
+rao,you xi,cha,qi qian,qin ban,pan jia yu fu,pu ao xi,zhe pi zhi,qi zhi,sun,kan e den zhao,hua cheng,zheng
+ji,qi yan kuang,wang bian chao,suo ju wen hu yue jue ba,pa qin dan,shen zheng yun wan
+ne,ni,na,rui yi shu zhua pou tou,dou dou kang,gang zhe,she,ti pou fu pao ba ao,niu ze tuan
+kou lun qiang yun hu bao bing zhi,zhai peng,beng nan bu,pu,ba pi tai,chi yao,tao zhen zha
+yang bao,pao,pou he,qia ni ye,she di,zhi,qi chi pi,pei jia mo,ma mei chen,shen ya,xia,jia chou qu min
+chu jia,ya fu,bi,pi,fei zha,zhan zhu dan,jie chai,che,chi,ca mu nian,dian la fu,bu pao ban,pan pai,bo lin,ling na
+guai qian ju tuo,ta,zhi ba,bo,bie,fa,bei tuo tuo,chi ao,niu,yu ju,gou zhuo pan,bian,fen,fan,pin zhao,qiao,shao bai bai di ni
+ju kuo long jian qia yong lan ning bo ze,zhai qian hen kuo,gua shi jie,jia zheng
+nin gong,ju gong quan shuan,quan cun,zun za,zan kao yi,chi,hai xie ce,se,chuo hui pin,bing zhuai,ye shi,she,jie na
+bai chi gua zhi,die kuo,guang duo duo zhi qie,qi,jia,qia,shi an nong zhen ge,he jiao kua,ku,kou dong
+na,ru,nu tiao,tao,diao lie zha lv die,she wa jue lie ju zhi luan ya wo,zhua ta xie,jia
+nao dang jiao zheng ji hui xian yu ai tuo nuo cuo,zuo bo geng ti zhen
+cheng sa,sha,suo sa,suo,sha keng mei nong ju peng jian yi ting shan,yan rua,ruo,sui,luo wan xie,jia cha
+feng jiao,ku wu jun jiu,ju,qiu tong kun,hun huo,chi tu,shu,cha zhuo pou,fu lv,luo ba,bie han,xian,gan shao,xiao,qiao nie
+juan,yuan ze shu,sou,song ye,yu jue,zhuo bu wan,gua bu,pu,zhi zun ye zhai lv sou tuo,shui,yan lao sun
+bang jian huan dao wei wan,yu qin peng,feng she lie,li min men fu,bu bai,ba,bi ju dao
+wo,luo ai juan,quan yue zong chen,tian,nian chui,duo jie,qie,cha tu ben na nian,nie ruo,wo,wei,re zuo,cu,su,zun wo,xia qi
+xian,hen cheng dian sao lun qing gang duo,zhuo shou diao,nuo pou,fu,pei di zhang hun ji,yi tao
+qia qi pai,bai shu qian,wan ling ye ya jue,ku zheng liang gua yi,ni,nai,nie huo,xu shan,yan zheng,ding
+lve cai tan,xian che bing jie,xie,sha,cha ti kong,qiang tui yan cuo,ze,ci zhou,zou,chou ju tian qian ken
+bai pa,shou jie lu guai,guo ming jie zhi dan,shan meng can,chan,shan sao guan peng yuan,chuan nuo
+jian zheng,keng jiu,you jian,qian yu,chou,you,shu,yao yan kui nan hong,xuan,ju rou pi,che wei sai,cai zou,cou xuan miao,mao
+ti,di,chi,shi nie cha,zha shi zong,song zhen yi,ji xun yong,huang bian yang huan yan zan,zuan an,yan,ye xu,ju
+ya wo,ou ke,qia chuai,duo,zhui,tuan ji ti,di la la chen kai,jia jiu jiu tu jie,qi,he hui,hun gen
+chong,dong xiao,shuo,xian die,she,ye xie,jia yuan,huan qian,jian ye cha zha bei yao wei beng lan wen,wu qin
+chan ge lou zong gen jiao gou qin rong que,huo chou,zou,zhu chuai,chi,yi zhan sun sun bo
+chu rong,nang bang,peng,beng cuo,chai sao ke,e yao dao zhi nu,nuo,nou la,xie,xian jian,lian sou,xiao,shao qiu gao,qiao,kao xian
+shuo sang jin mie e,yi chui,dui nuo shan ta,da zha,jie tang pan,ban,po ban,su da,ta li tao
+hu,ku zhi,nai wa hua,xia,qia qian wen qiang,cheng tian,shen zhen e xie nuo quan cha zha ge
+wu en she kang she shu bai yao bin sou tan sa,shai,sha chan,sun suo jiu,liu,liao,jiao,nao chong
+chuang guai,guo bing feng,peng shuai di,tu,zhi qi,cha sou,song zhai lian cheng chi guan lu luo lou
+zong gai,xi hu,chu zha,zhua chuang tang hua cui,zui,cuo nai,zhi mo,ma,mi jiang,qiang gui ying zhi ao,qiao zhi
+nie,che man chan,can kou,ou chu,chi she,su,mi tuan,zhuan jiao,chao mo mo zhe,la,xie can,shan,chan,sen keng,qian biao,piao,pao jiang yao
+gou qian liao ji ying jue,gui pie pie,bie lao dun xian ruan,rui,run,ruo,sui gui zan,zen,qian yi xian,xun
+cheng cheng sa nao,xiao,rao hong si,xi han,qian guang da zun nian lin zheng,cheng hui,wei zhuang jiao,kao
+ji cao dan,tan,xin dan,chan,tan,zhan,shan,tian che bo,fa che jue fu,xiao,sou liao,lao ben fu,mo qiao bo cuo,zuo,zui,zuan,chua zhuo
+zhuan,xuan,suan wei,tuo pu,bu qin dun nian hua xie lu jiao cuan ta han qiao,yao,ji wo,zhua jian,lian
+gan yong lei nang lu shan zhuo ze,zhai,yi pu chuo ji,xi dang se cao qing qing,jing
+huan,juan,xuan jie qin kuai dan,shan xie ka,qia,jia,zha,gua,ye,ge,lie pi,bo bai,bo ao ju ye e meng sou mi
+ji tai zhuo dao,chou xing lan ca ju ye ru,nu,nou,ruan ye ye ni wo,huo,hu jie bin
+ning ge zhi zhi,jie kuo,tang,guang mo jian xie lie,la tan bai sou lu lve,li,yue rao ti,zhi,zhai
+pan yang lei ca,sa shu,lu zan nian xian jun,pei huo,que li la,lai huan ying lu,luo long
+qian qian zan,cuan qian lan xian,jian ying mei rang,ning,xiang chan,shan weng cuan xie she,zhe,nie,sha luo jun
+mi,mo chi zan,cuan,zuan luan,lian tan,nan zuan li,shai dian wa dang,tang jiao jue lan li,luo nang zhi,qi
+gui gui qi,ji xun pu pu shou kao you gai yi gong gan,han ban,bin fang zheng
+po dian kou min,fen wu,mou gu he ce xiao mi chu,shou ge di,hua xu jiao min
+chen jiu shen duo yu chi,sou ao bai xu jiao duo lian nie bi chang,cheng,zheng dian
+duo,que yi gan san ke yan,jiao dun,dui,tuan,diao,dao,zhun,tun ji,qi tou xiao,xue duo jiao,qiao jing yang xia min
+shu,shuo ai,zhu qiao ai zheng di zhen fu shu,shuo liao qu,ou xiong yi jiao shan jiao
+zhuo,zhu yi,du,tu lian bi li,tai xiao,xue xiao wen xue qi qi zhai bin jue zhai lang
+fei ban ban lan yu lan wei dou,zhu sheng liao jia hu xie,xia,cha,ye jia yu zhen
+jiao wo,guan tiao,tou dou jin chi,che,zhe yin,zhi fu qiang zhan qu zhuo,chuo zhan duan cuo,zhuo si,shi
+xin zhuo zhuo qin,jin lin zhuo chu duan zhu fang,pang,wang,feng chan,jie hang yu,wu shi,yi pei you,liu
+mei pang,peng,beng,bang qi zhan mao,wu lv pei pi,bi liu fu fang xuan jing jing ni zu,sou,cou,zou
+zhao yi liu shao jian yu yi qi zhi fan piao fan zhan kuai sui yu
+wu,mo ji ji,xi ji huo ri dan jiu zhi zao xie tiao xun,jun xu ga,xu la
+gan,han han tai,ying di xu chan shi kuang yang shi wang min min tun,zhun chun wu
+yun bei ang,yang ze ban jie kun,hun sheng hu fang hao gui,jiong chang xuan ming,meng hun
+fen qin hu yi xi,cuo xin,xuan yan ze fang tan,yu shen ju yang zan bing,fang xing
+ying,yang xuan po,pei zhen ling chun hao mei,wen,mo zuo mo bian xu,xiong hun zhao zong shi,ti
+shi,xia yu fei die,yi mao ni,zhi chang wen dong ai bing ang zhou long xian kuang
+tiao chao,zhao shi huang huang xuan kui xu,kua jiao jin zhi jin shang tong hong yan
+gai xiang shai xiao ye yun hui han han jun wan xian kun zhou xi cheng,sheng,jing
+sheng bu zhe,zhi zhe wu wan hui hao chen wan tian zhuo zui zhou pu jing,ying
+xi shan ni xi qing qi,du jing gui zheng yi zhi an,yan wan lin liang chang
+wang xiao zan fei xuan geng,xuan yi xia,jia yun hui xu min kui ye ying shu,du
+wei shu qing mao nan jian,lan nuan,xuan an yang chun yao suo pu ming jiao kai
+gao,hao weng chang qi hao yan li ai,nuan ji,jie ji men zan xie hao mu mo
+cong ni zhang hui bao,pu,bo han xuan chuan liao xian tan jing pie lin tun xi
+yi ji huang dai ye ye li tan tong xiao fei shen zhao hao yi xiang,shang
+xing shen jiao bao jing yan ai ye ru shu meng xun yao pu,bao li chen
+kuang die liao yan huo lu xi rong long nang luo luan shai tang yan zhu
+yue yue qu ye geng ye hu he,e shu cao cao sheng man ceng ceng,zeng ti
+zui,cuo can,qian,jian xu hui,kuai,kuo yin qie fen pi yue,ru you,wei ruan,wan peng fen,ban fu,bi,bo ling fei,ku
+qu,xu,chun ti nv tiao,you shuo zhen lang lang zui,juan ming huang,mang,wang,meng wang tun chao,zhao,zhu ji,qi qi,ji
+ying zong wang tong,chuang lang lao meng,mang long mu deng wei mo,me ben zha,ya shu,zhu shu,zhu
+mu zhu,shu ren ba pu,piao,po duo duo dao,mu,tiao li gui,qiu ji,wei jiu bi xiu cheng,zheng,ting ci
+sha ru za,duo quan qian yu,wu gan wu cha shan,sha xun fan wu,wo zi li xing
+cai cun ren,er biao,shao,shuo,di,zhuo tuo,zhe di,duo zhang mang chi yi gai,ge gong du,tu li,zhi,yi,tuo,duo qi shu
+gang,gong tiao jiang mian wan lai jiu mang yang ma miao si,zhi,xi yuan hang,kang fei,bei bei
+jie dong gao yao xian,qian chu chun pa,ba shu,dui hua xin chou,niu zhu,shu chou song ban
+song ji wo,yue jin gou ji mao pi,bi bi,pi wang,kuang ang fang,bing fen yi fu nan
+xi,si hu ya,ye dou,zhu xin zhen,chen yao lin rui,nen e mei zhao guo,luo,guan zhi,qi cong,zong yun
+zui sheng shu zao di li lu jian cheng song qiang feng zhan xiao xian,zhen ku,gu
+ping tai,si,ci xi zhi guai xiao jia jia gou,ju,qu bao,fu mo yi,xie ye ye shi nie
+bi duo,tuo yi,duo,li ling bing ni,chi la he ban,pan fan zhong dai ci yang,ying fu bai,bo
+mou,mei gan,qian qi ran rou mao shao song zhe xia,jia you,zhou shen gui,ju tuo zha,zuo,ze nan,ran
+ning,chu,zhu yong di,chi zhi,die zha,zu cha,zha,chai dan gu bu,pu jiu ao fu jian ba,fu,bo,bie,pei duo,zuo,wu ke
+nai zhu bi,bie liu chai,ci,zhai,zi shan,zha si chu,zhu pei,bei shi,fei guai zha yao cheng,jue jiu shi
+zhi liu mei li rong zha,shan,ce zao biao zhan zhi long dong lu sheng li,yue lan
+yong shu xun,sun shuan,quan qi zhen qi,xi li,lie yi xiang zhen li se,ci gua,tian,kuo kan ben,bing
+ren xiao,jiao,qiao bai ren bing zi chou yi ci xu,yu zhu jian,zun zui er er you,yu
+fa gong kao lao zhan lie yin yang he,hu,gai,kai gen yi,zhi shi ge,luo,he zai luan fu
+jie heng,hang gui tao,tiao,zhao guang wei,gui kuang ru an an juan,quan yi,ti zhuo ku zhi qiong
+tong,dong sang sang huan ju,jie,xie jiu xue duo zhui yu,mou zan - ying jie liu zhan
+ya rao zhen dang qi qiao hua gui,hui jiang zhuang xun suo sha zhen,chen bei ting,ying
+kuo jing po,bo ben fu rui tong jue xi lang liu feng qi wen jun gan,han
+su,yin liang qiu ting you mei bang long peng zhuang di xuan,juan,xie tu,cha zao ao,you gu,jue
+bi di han zi zhi ren bei geng jian,xian huan wan nuo jia tiao ji xiao
+lv hun,kuan shao,xiao,sao cen,chen,qin fen song meng wu,yu li li,si,qi dou qin ying suo,xun ju ti
+xie kun,hun zhuo shu chan fan wei jing li bin,bing xia fo tao zhi lai lian
+jian zhuo,tuo,rui ling li qi bing lun cong,song qian mian qi qi,ji cai gun,hun,ao chan de,zhe
+fei pai,bei,pei bang bang,bei,pou,pei hun zong cheng,chang zao ji li,lie peng yu yu gu jun dong
+tang gang wang di,ti,dai cuo fan cheng zhan,chen qi yuan yan yu quan,juan yi sen ren,shen
+chui,duo leng,ling,cheng qi,xi zhuo fu,su ke,kuan lai zou,sou zou zhao,zhuo guan fen fen shen,chen qing ni,nie
+wan guo lu hao jie,qie yi chou,zhou,diao ju ju cheng,sheng zuo,cui liang qiang,kong zhi chui,zhui ya,e
+ju bei,pi,bi,pai jiao zhuo zi bin peng ding chu chang men hua jian gui xi du
+qian dao gui dian luo zhi quan ming fu geng peng shan yi tuo sen duo,chuan
+ye fu wei,hui wei duan jia zong jian,han yi shen,zhen xi yan,ya yan chuan jian,zhan chun
+yu he zha,cha wo pian bi yao huo,guo,kua xu ruo yang la yan ben hui kui
+jie kui si feng,fan xie tuo zhi,ji jian mu mao chu hu,ku hu lian leng ting
+nan yu you mei song,cong xuan,yuan xuan yang zhen pian ye,die ji jie,qia ye chu,zhu dun,shun,chun
+yu zou,cou wei mei ti,di,shi ji jie kai,jie qiu ying rou huang lou le quan xiang
+pin shi gai,gui,jie tan lan wen,yun yu chen lv ju shen chu bi xie jia yi
+zhan,chan,nian,zhen fu,bo nuo mi lang rong gu jian,jin ju ta yao zhen bang,beng,pang,peng sha,xie yuan zi
+ming su jia yao jie huang gan,han fei zha qian ma sun yuan xie rong shi
+zhi cui wen ting liu rong tang que zhai si sheng ta ke xi gu qi
+gao,kao gao,kao sun pan tao ge chun dian,zhen nou ji shuo gou,jue chui,zhui,dui qiang,cheng cha qian,xian,lian
+huai mei xu gang gao zhuo tuo qiao yang dian jia kan,jian zui dao long bin,bing
+zhu sang xi,die ji,gui lian hui yong qian guo gai gai tuan,shuan,quan hua qi,zu,se sen,shen cui,zui
+peng you,chao hu jiang hu huan gui nie,xie,yi yi gao kang gui gui cao,zao man,wan jin,qin
+di,zhi,zhe zhuang,chong le,yue,yao,luo,liao lang chen cong,zong li,chi xiu qing shuang fan tong guan ze su lei
+lu liang mi lou,lv chao,jiao su ke chu tang,cheng biao lu,du jiu,liao zhe zha shu,ou zhang
+man,lang mo,mu niao,mu yang,xiang tiao peng zhu sha xi quan heng,guang,huang jian cong ji yan qiang
+xue ying er,zhi xun zhi,yi qiao zui cong pu shu hua kui zhen zun yue shan
+xi chun dian fa,fei gan mo wu qiao rao,nao lin liu qiao,jiao xian run fan zhan,jian
+tuo,du,luo lao,liao yun shun dun,tui cheng tang,cheng meng ju cheng,deng,chen su,xiao,qiu jue jue dian,tan,xin hui ji
+nuo xiang tuo,duo ning rui zhu tong,chuang,zhong,chong zeng,ceng fen,fei qiong ran,yan heng qian,qin gu liu lao
+gao chu xi sheng zi san ji dou jing lu jian chu yuan ta shu,qiao,sao jiang
+tan,shan lin nong yin xi hui shan zui xuan cheng gan ju zui yi qin pu
+yan,dan lei feng hui dang ji sui bo,bi ping,bo cheng chu zhua gui,kuai,hui ji jie,xie jia
+qing,jing zhai,shi,tu jian qiang dao yi biao song she lin li cha,sa meng yin tao,chou,dao tai
+mian qi tuan bin,bing huo,hua ji qian ni,mi ning yi gao kan,jian yin nou,ruan,ru qing yan
+qi mi zhao,di gui chun ji kui po deng chu ge mian you zhi huang,guang,guo,gu qian
+lei lei sa lu li cuan lv,chu mie,mei hui ou lv zhi gao du yuan li,luo,yue
+fei zhuo,zhu sou lian jiang chu qing zhu lu,lv yan li zhu chen,qin,guan jie,ji e su
+huai,gui nie yu long lai jiao xian gui ju xiao,qiu,xiu ling ying jian,shan yin you ying
+xiang,rang nong bo chan,zhan lan,lian ju shuang she wei,zui cong quan,guan qu cang jiu yu luo
+li cuan,zuan luan dang,tang jue yan lan lan zhu lei,luo li ba nang yu ling guang
+qian ci,zi huan xin yu yi,huan,yu qian,han,xian ou xu chao chu,xi,qu qi kai,ai yi,yin jue xi,kai
+xu he,xia yu kui lang kuan shuo,sou xi ai,e,xie,ei yi,qi qi chua,xu chi,chuai qin,yin kuan,xin kan,qian,dan
+kuan kan,ke,qian chuan sha,xia gua yin xin xie,ya yu qian xiao ye ge wu,yang tan jin,qun
+ou hu ti,xiao huan xu pen xi,yi xiao chua,xu she,xi,xie shan han,lian chu yi e yu
+chuo huan zhi zheng ci bu wu qi bu bu wai ju qian chi,zhi se chi
+se,sha zhong sui,suo sui li ze yu li gui,kui dai,e e si jian zhe mo,wen mo
+yao mo,wen cu yang tian sheng dai shang xu xun shu can jue piao,bi qia qiu
+su qing,jing yun lian yi fou,ye,bo zhi,shi ye,yan can hun,men dan ji die zhen yun wen
+chou bin ti jin shang yin diao jiu hui,kui cuan yi dan du jiang lian bin
+du jian jian shu ou duan zhu yin,yan qing,keng,sheng yi sha,shai,sa,xie,shi qiao ke,qiao xiao,yao xun dian
+hui hui gu qiao ji yi ou,kou,qu hui duan yi xiao wu,mou guan mu,wu mei mei
+ai jie du,dai yu bi,pi bi bi pi pi bi chan mao hao cai pi lie
+jia zhan sai mu,mao tuo xun er rong xian ju mu hao qiu dou,nuo sha tan
+pei ju duo cui,qiao,xia bi san san mao sai,sui shu,yu shu tuo he,ke,da jian ta san
+lv,shu,yu,dou mu mao,li tong rong chang pu lu zhan sao zhan meng lu qu die shi,zhi,jing
+di,zhi min jue mang,meng qi pie nai qi dao xian chuan fen yang,ri nei bin fu
+shen dong qing qi,xi yin,yan xi hai yang an ya ke qing ya dong dan lv
+qing yang yun yun shui shui zheng,cheng bing yong dang shui le ni,mei tun,qiu fan gui,jiu,qiu
+ting,ding zhi,xie,shi qiu bin,pa ze mian cuan hui diao han cha zhuo,yue,que,shuo chuan wan,huan fan,fa da,tai
+xi tuo mang qiu,you qi shan,shuan pin,chi han,gan qian wu,yu,wa wu xun si ru gong jiang
+chi,tuo,che wu tu jiu tang,shang zhi,ji zhi qian,yan mi gu,yu,hu wang,hong jing jing rui,tun jun hong
+tai quan,fu ji bian bian gan,han,cen wen,min,men zhong fang,pang xiong jue,que,xue hu,huang niu,you qi,gai,yi fen,pen xu
+xu qin yi,yin wo yun yuan hang,kang yan,wei shen,chen,tan chen dan you dun,zhuan,tun,chun hu huo qi,qie
+mu nv,niu mei da,ta mian mi,wu,fu chong pang,tian bi sha,suo zhi pei pan zhui,zi za gou
+liu mei,mo,me ze feng ou li lun cang feng wei hu mo mei,hui shu ju,jian,zu za
+tuo,duo tuo,duo,chi tuo he li,zhen mi yi,chi,shi fa fei,fu you tian zhi,chi zhao gu zhan,tian,dian,chan yan
+si kuang jiong,ying ju,gou xie,yi qiu,you yi,die jia zhong quan po,bo hui mi,bi ben ze zhu,ku
+le you,ao gu hong gan,han fa mao,liu si hu ping,peng ci,zi fan,feng,fa zhi,chi su ning,zhu cheng
+ling pao bo,bei,bi qi,li,se si ni,nie,ning ju sa,xue zhu,zhou sheng lei xuan,juan jue,xue fu pan min,mian
+tai yang ji yong guan beng,pin,liu xue long,shuang lu dan luo,po xie po ze jing yin
+pan,zhou jie,ji ye hui hui zai cheng yin,yan,ye wei hou jian,cun yang,xiang lie si ji er
+xing fu sa,xi,xian,sen,cui,xun se,qi,zi zhi yin wu xi,xian kao zhu jiang,hong luo luo an,yan,e dong,tong ti
+mou lei yi mi quan jin po wei xiao xie,yi hong xu,yi su,shuo kuang tao,yao,dao qie,jie
+ju er zhou ru ping,peng xun,xuan xiong zhi guang,huang huan ming huo,guo wa,gui qia,he pai,mai,bai,pa wu,hu
+qu liu yi jia jing qian,jian jiang jiao zhen shi zhuo ce fa hui,kuai ji liu
+chan hun hu,xu nong xun jin lie qiu wei zhe jun,xun,cun han,gan bang,bin mang zhuo you,di
+xi bo dou huan hong yi,ya pu ying,cheng,zheng lan hao,gao,ge lang han li,hai geng fu wu
+lian,li chun feng,hong yi yu tong lao hai jin,qin jia,xia chong jiong mei sui,nei cheng pei
+xian,jian shen tu,chu,ye kun ping nie han jing,qing xiao she,die nian,ren tu yong,chong xiao xian,yan,dian ting
+e su,sou,shu tun,yun juan,yuan,xuan cen,qian,zan ti li shui si lei shui tao du lao lai lian
+wei wo,guo yun huan,hui di heng run jian zhang se fu,pou guan xing shou,tao shuan,shua ya
+chuo zhang ye,shi kong,nang wo,yuan,wan han tuo dong he wo ju she liang hun ta zhuo
+dian qie,ji de juan zi xi xiao qi gu,hu guo,guan yan,han lin tang,chang zhou,diao peng hao
+chang shu,chu qi,qian fang zhi lu nao,zhao,zhuo,chuo ju tao cong,shuang lei,li zhe ping,peng fei song tian
+pi,pei dan,yan,tan yu,xu ni yu lu gan,han mi jing,cheng ling lun,guan yin,yan,yao cui,zu qu huai yu
+nian,shen,na shen biao,hu chun,zhun hu yuan lai hun,gun,kun qing yan qian,jian,can,zan tian miao zhi yin bo
+ben yuan wen,min ruo,re fei qing yuan ke ji she yuan se lu zi du yi
+jian mian,sheng pai xi yu yuan shen shen rou huan zhu jian nuan yu qiu,wu ting
+qu,ju du fan,feng zha bo wo,ou,wu wo,guo di,ti wei wen,yun ru xie,die,zha,yi,qie ce wei he gang,hong
+yan hong,gong xuan mi ke,jie,kai,he mao ying yan you,liu hong,qing miao sheng mei zai hun,gun nai
+gui chi e pai,ba mei lian,lan qi qi mei tian cou wei can tuan,zhuan mian hui,min
+mo xu ji pen jian,zan,zhan,qian jian hu feng xiang yi yin zhan,chen,dan,tan,jin,yin,shen shi jie,xie zhen,cheng huang,kuang
+tan yu bi min,hun,mian shi tu sheng yong ju dong,tong tuan,nuan jiao,qiu,jiu jiao qiu yan,yin tang,shang,yang
+long huo yuan nan ban,pan you quan zhuang,hun liang chan xian chun nie zi wan shi
+man ying la kui,hui feng jian xu lou wei gai,xie bo ying po jin yan,gui tang
+yuan suo yuan lian,xian,nian,lin yao meng zhun,zhuo cheng ke,kai tai ta,da wa liu gou,gang,kou sao ming,mi
+zha shi yi lun ma pu,fu,bu,bo,po wei,mei li zai wu xi,qi wen qiang ze shi su,shuo
+ai qin,zhen sou,shao yun xiu,chou yin rong hun su suo,se ni,ruo,niao ta shi ru ai pan
+chu,xu chu pang,peng weng cang mie ge dian,tian,zhen hao,xue huang xi,xie,qi zi,ci,xuan di zhi xing,ying fu
+jie hua,gu ge zi tao teng sui bi jiao hui gun yin gao long zhi yan
+she man ying chun lv lan luan yao,xiao bin tan yu xiu hu bi biao zhi,chi
+jiang kou shen,sen,qin,lin shang di mi ao lu hu,xu hu you chan fan yong gun man,men
+qing yu piao,biao ji ya chao qi,qie xi ji lu lou,lv long jin guo cong,song lou
+zhi gai qiang li yan cao jiao cong chun tuan,zhuan ou teng ye xi mi tang
+mo shang,tang han,tan lian,lan lan wa chi,tai gan feng,peng,beng xuan yi man zi,se,qi mang kang luo,ta,lei
+peng shu zhang zhang zhuang,chong,shuang,chuang xu huan huo,kuo jian,qian,chan yan shuang,chuang liao,xiao,liu cui ti yang jiang
+cong ying hong xiu shu guan ying xiao zong kun xu lian zhi wei pi,pie,piao yu,jue,shu
+jiao,qiao po,bo dang,xiang,yang hui jie wu pa ji pan,bo,fan wei,gui su,xiao,sou qian qian xi,ya lu xi
+xun,sun dun huang,guang min run su lao,liao zhen cong,zong yi zhe,zhi wan shan,tan tan,xun,yin,dan chao xun,yin
+kui,xie ye shao tu,zha zhu sa,san hei bi shan chan chan shu tong,chong,zhong pu lin wei
+se se cheng jiong cheng,deng hua jiao,ao,nao lao che gan,han cun hong si shu,zhu peng han
+yun liu hong fu hao he xian jian shan xi yu lu lan ning yu lin
+mian,sheng zao,cao dang huan,han ze,shi,yi,duo xie yu li shi,cuo xue,xiao ling wan,man,ou zi,ci yong hui,kuai,hua can
+lian dian ye ao,yu huan,xuan zhen chan,dan,zhan man dan dan,tan,shan yi sui pi ju ta qin
+ji,jiao zhuo lian,xian nong guo,wo jin fen,pen se ji,sha sui hui,wei,huo chu ta song ding,ting se
+zhu lai bin lian mi,ni shi,ta,xi shu mi ning,ni ying ying meng jin qi bi,pi ji,qi
+hao ru,ruan,er,nuan,nuo cui,zui wo tao,chao,shou,dao yin yin dui ci huo,hu qing lan,jian jun,xun ai,kai,ke pu zhuo,shuo,zhao
+wei bin gu qian ying bin kuo fei cang me jian,zan wei luo,po,li zan lv li
+you yang lu si zhi ying,jiong du,dou wang hui xie pan shen,chen,pan biao chan mo,mie liu
+jian pu,bao,bo se cheng gu bin huo xian lu qin han ying rong li jing xiao
+ying sui wei,dui xie huai,wai xue zhu long,shuang lai dui fan hu lai shu ling ying
+mi,ni ji lian jian,zun ying fen lin yi jian yue,yao chan dai rang,nang jian lan fan
+shuang yuan zhuo,ze,jiao feng she,ni lei lan cong qu yong qian fa guan,huan jue yan hao
+ying sa,xian,xi,li,shi zan,cuan,qian,za luan yan li mi shan tan,han,nan dang jiao chan ying hao ba zhu
+lan lan nang wan luan xun,quan xian yan gan yan yu huo biao,huo mie guang deng,ding
+hui xiao xiao hui hong ling zao zhuan jiu zha,yu xie chi zhuo zai zai can
+yang qi zhong fen,ben niu jiong,gui wen pu yi lu chui pi kai pan yan,tan kai,yan
+pang,feng mu chao liao gui,que,xue kang,hang dun,tun guang xin zhi guang guang wei qiang bian da
+xia zheng zhu ke zhao fu ba xie xie ling zhuo,chu xuan ju tan pao,bao jiong
+pao,fou tai tai bing yang tong shan zhu zha dian wei shi lian chi huang zhou
+hu shuo lan ting jiao,yao xu heng quan lie huan yang xiu,xiao xiu xian yin wu,ya
+zhou yao shi wei tong,dong mie zai kai hong lao,luo xia zhu,chong xuan,hui zheng po yan,yin
+hui,ai guang che hui kao ju fan shao ye hui - tang jin re lie xi
+fu jiong xie,che pu ting,jing zhuo ting wan hai peng lang yan,shan xu feng chi rong
+hu xi shu he,huo xun,hun ku,kao juan,ye,yue,yuan xiao xi yan,yi han zhuang jun,qu di xie ji,qi
+wu yan lv han yan huan men ju dao,tao bei fen lin kun hun tun,tui,jun xi
+cui wu,mo hong chao,ju fu wo,ai jiao,qiao cong feng ping qiong ruo,re xi,yi qiong xin chao,zhuo,chuo
+yan yan,yi yi jue yu gang ran pi xiong,ying,gu gang sheng chang,gua shao xiong nian geng
+wei chen he kui zhong duan xia hui,hun,yun,xun,xuan feng lian,lan xuan xing huang jiao jian bi
+ying zhu wei,hui tuan shan,qian xi nuan,xuan nuan chan yan jiong jiong yu mei sha wei
+zha,ye jin qiong rou mei huan xu,xiu zhao wei,yu fan qiu sui yang lie zhu jie
+zao gua bao hu yun,wen nan shi liang bian gou tui tang chao shan en,yun bo
+huang,ye xie xi wu xi yun he he,xiao,kao xi yun xiong nai shan qiong yao xun
+mi lian,qian ying,xing,jiong wu rong gong yan qiang liu xi,yi bi biao cong,zong lu,ao jian shu,shou
+yi lou peng,beng,feng sui,cui yi teng,tong jue zong yun,yu,wei hu yi zhi ao wei liu han,ran
+ou re jiong man kun shang cuan zeng jian xi xi xi yi xiao chi huang
+chan,dan ye tan,xun,qian ran yan xun qiao,xiao jun deng dun,tun shen jiao,qiao,jue,zhuo fen,ben si,xi liao yu
+lin tong shao fen fan,fen yan xun,qian lan mei tang,dang yi jiong men jing jiao ying,cuo
+yu,ao yi xue lan tai,lie zao,sao can sui xi que zong lian hui zhu,kuo xie ling
+wei yi xie zhao hui da nong lan ru,ruan xian,bing he xun jin chou dao,tao yao,shuo,shao
+he lan biao rong li,lie mo bao,bo ruo lv la,lie ao xun kuang,huang shuo,luo,yue liao li
+lu jue liao yan,xun xi xie long ye can rang yue lan cong jue,jiao chong,tong guan
+ju che mi tang lan zhu lan ling cuan yu zhao,zhua zhao pa zheng pao cheng
+yuan ai wei han jue jue fu ye ba die ye yao,xiao zu shuang er,mi,ni pan,qiang
+chuang ke zang die qiang yong qiang pian,pan ban pan chao jian pai du chuang yu
+zha bian,mian die bang,pang bo chuang you you du ya cheng niu niu pin jiu,le mou,mu,mao
+ta,tuo mu lao,lou ren mang fang mao mu gang wu yan ge,qiu,zang bei si jian gu
+you,chou ge sheng mu di,zhai qian quan quan zi te xi mang keng qian wu gu
+xi li li pou ji,yi gang zhi,te ben quan chun du ju jia jian,qian feng pian
+ke ju kao chu xi bei luo jie ma san wei mao,li dun tong qiao jiang
+xi li du lie pai piao,pao bo xi,suo chou wei kui,rao chou quan quan ba fan
+qiu ji chai zhuo an,han,jian ge,he zhuang guang ma you kang,gang bo,pei,fei hou ya yin huan,fan
+zhuang yun kuang,jue niu,nv di,ti kuang zhong mu bei pi ju yi,quan,chi sheng,xing pao xia tuo,yi
+hu ling fei pi ni yao you gou xue ju dan bo ku xian ning huan,xuan,heng
+hen,yan,ken,hang jiao,xiao he,mo zhao ji,jie,kuai xun shan ta,shi rong shou tong,dong lao du xia shi kuai
+zheng yu sun yu bi mang,zhuo xi,shi juan li xia yin suan,xun,jun lang,hang bei zhi yan
+sha li han xian jing pai fei xiao bai,pi qi ni biao yin lai lie,xi,que jian
+qiang kun yan guo,luo zong mi chang yi,ji,e,wei zhi zheng ya,wei meng cai cu she lie
+dian luo hu zong gui wei feng wo yuan xing zhu mao,miao wei chuan,shan xian tuan
+ya,jia,qie nao xie,he,ge,hai jia hou bian,pian you,yao you mei cha yao sun bo,po ming hua yuan
+sou ma yuan dai,ai yu shi hao qiang yi zhen cang hao,gao man jing jiang mo,mu
+zhang chan ao ao hao cui ben,fen jue bi bi huang pu lin xu,yu tong,zhuang yao,xiao
+liao,lao shuo xiao shou dun jiao ge,xie,lie juan du hui kuai,hua xian xie,ha,jie ta xian,mi xun
+ning bian huo nou,ru meng lie nao,you guang,jing shou lu ta xian,suo,xi mi rang huan,quan nao
+luo,e xian qi jue xuan miao,yao zi,xuan lv,shuai,lve lu yu su wang,yu qiu ga ding le
+ba ji hong di chuan gan jiu yu qi yu chang,yang ma hong wu fu wen,min
+jie ya bin,fen bian bang yue jue men,yun jue wan jian,yin,qian,lin mei dan pin wei huan
+xian qiang ling dai yi an,gan ping dian fu xuan,xian xi bo ci,cuo gou jia shao
+po ci ke ran sheng shen yi,tai zu,ju jia min shan liu bi zhen zhen jue
+fa long jin jiao jian li guang xian zhou gong yan xiu yang xu luo,li su
+zhu qin yin,ken xun bao er xiang yao xia hang,heng gui chong xu ban pei lao
+dang ying hui,hun wen e cheng,ting di,ti wu wu cheng jun mei bei ting xian chu
+han xuan,qiong yan qiu xuan lang li xiu fu liu ya xi ling li jin lian
+suo suo feng wan dian pin,bing zhan se,cui min yu ju chen lai min sheng,wang wei,yu
+tian chu zuo,zhuo beng,pei cheng hu qi e kun chang qi beng wan lu cong guan,gun
+yan diao bei lin qin pi pa que zhuo qin fa jin qiong du jie hun,hui
+yu mao mei chun xuan ti xing dai rou min jian wei ruan huan xie chuan
+jian zhuan chang,yang,dang lian quan xia duan yuan,huan ya nao hu ying yu huang rui se
+liu shi rong suo yao wen wu zhen jin ying ma tao liu tang li lang
+gui zhen,tian qiang,cheng,cang cuo jue zhao yao ai bin shu,tu chang kun zhuan cong jin yi
+cui cong qi li jing suo,zao qiu xuan ao lian men zhang yin ye ying wei,zhi
+lu wu deng xiu zeng xun qu dang lin liao qiong,jue su huang gui pu jing
+fan jin liu ji hui jing ai bi can qu zao dang jiao gun tan hui,kuai
+huan se sui tian chu yu jin lu,fu bin,pian shu wen zui lan xi zi,ji xuan
+ruan wo gai lei du li zhi rou li zan qiong,xuan ti gui sui la long
+lu li zan lan ying mi,xi xiang qiong,wei guan dao zan huan,ye,yan gua bo die bo,pao
+hu,huo,gu zhi,hu piao ban rang li wa - xiang,hong qian,wa ban pen fang dan weng ou
+- - wa hu ling yi ping ci bai juan chang chi - dang meng bu,pou
+zhui ping bian zhou zhen,juan - ci ying qi xian lou di ou meng zhuan,chuan beng
+lin zeng wu pi dan weng ying yan gan,han dai shen tian tian han chang sheng
+qing shen chan chan rui sheng su shen yong shuai lu fu,pu yong,dong beng,qi feng ning
+tian you,yao jia shen zha,you dian fu nan dian,tian,sheng,ying ping ting,ding,zheng,tian hua ting zhen,quan,zhun zai,zi meng,mang
+bi bi liu xun liu chang mu yun,tian fan fu geng tian jie jie quan wei
+fu,bi tian mu duo pan jiang wa da,fu nan liu ben zhen chu,xu mu,mou mu ce,ji
+tian gai bi da zhi,chou,shi lve qi lve pan,fan yi fan,pan,bo,po,pi hua she,yu yu mu jun
+yi liu she die chou hua dang zhui ji,qi wan,yuan jiang cheng chang tun,tuan lei ji
+cha liu die tuan lin jiang jiang chou pi die die pi,shu,ya jie,qie dan shu shu
+zhi,di yi,ning ne nai ding,ne bi jie liao gang,gong ge,yi jiu zhou xia shan xu nve,yao
+li yang chen you ba jie jue,xue qi xia,ya cui bi yi li zong chuang feng
+zhu pao pi gan ke,e,qia ci,zi,zhai,ji xue zhi dan,da zhen,chen fa,bian zhi teng ju ji fei
+ju,gou shan jia xuan zha bing nie,ni,nian zheng yong jing quan teng,chong tong yi jie wei,you,yu
+hui tan,shi yang chi zhi hen,gen ya mei dou jing xiao tong tu mang pi xiao
+suan fu,pu li zhi cuo duo wu,pi sha lao shou huan,tuan xian yi beng,peng,bing zhang guan
+tan fei ma lin chi ji tian,dian an,ye,e chi bi bi min gu dui e,ke wei
+yu cui ya zhu cu dan shen zhong chi,zhi yu hou feng la yang,dang chen tu
+yu guo wen huan ku jia,xia yin yi lou sao jue chi xi guan yi wen,wo,yun
+ji chuang ban hui,lei liu chai,cuo shou nve,yao dian,chen da bie tan zhang biao shen cu
+luo yi zong chou,lu zhang zhai,ji sou se que diao lou lou,lv mo qin yin ying
+huang fu liao,shuo long qiao liu lao xian fei dan,tan yin he ai,yan ban xian guan
+gui,wei nong yu wei yi yong pi lei li,lai shu dan lin,bing dian lin lai bie
+ji chi yang xuan jie zheng me li huo lai,la ji dian xuan ying yin qu
+yong tan dian luo luan luan bo bo gui ba fa deng,de fa,bo bai,bo bai,bo,mo qie,bie
+ji,xiang,bi zao zao mao de,di pa,ba jie huang,wang gui ci ling gao,hao,gu mo ji jiao peng
+gao ai e hao,hui han bi wan,huan chou qian xi ai xiao,jiao,po hao huang hao ze
+cui hao xiao ye po,pan hao jiao ai xing huang li,luo,bo piao he jiao pi gan
+pao zhou jun qiu cun que zha gu jun jun zhou zha,cu gu zhao,zhan,dan du min,ming
+qi ying yu bei zhao zhong,chong pen he ying he yi bo wan he,ke ang zhan
+yan jian he,an yu,wu kui fan gai,ge dao pan fu qiu sheng,cheng dao lu zhan meng,ming
+li jin xu jian,kan pan,xuan guan an lu,lv,lei xu zhou,chou dang an gu li mu ding,cheng
+gan xu mang wang,mang zhi qi yuan tian,xian,min xiang dun,zhun xin xi,pan pan,fen feng dun,shun,yun min
+ming sheng,xing,xian shi yun,hun mian pan fang miao dan,chen mei mao,mei kan xian kou shi yang,ying
+zheng yao,ao shen huo da zhen kuang ju,xu,kou shen yi,chi sheng mei mo,mie zhu zhen zhen
+mian,min shi yuan die,chou ni zi zi chao zha xuan,huan,juan bing,fang mi,pan long sui,hui,xie,wei tong mi
+die,zhi di ne ming xuan,shun,xun chi kuang juan mou zhen tiao yang yan,wen mo,mi zhong mo
+zhe,zhao,zhuo zheng mei suo,jun,juan shao,qiao,xiao han huan di,ti cheng cuo,zhuai juan e man xian xi kun
+lai jian shan tian gun,huan,lun wan leng,cheng shi qiong lie ya jing zheng li lai sui,zui
+juan shui sui,hui,wei du bi pi mu hun ni lu yi,ze,du,gao jie,she cai zhou yu hun
+ma xia xing hui gun zai chun jian mei du hou xuan tian kui,ji gao,hao rui
+mao,wu xu fa wo miao chou kui mi weng kou,ji dang chen,tian,shen ke sou xia qiong,huan
+mo ming,meng,mian man fen ze zhang yi diao,dou kou mo shun cong lou,lv chi man,men piao
+cheng,zheng gui meng,mang wan run,shun pie,bi xi qiao pu zhu deng shen shun liao che xian,jian
+kan ye xu,xue tong mou,wu,mi lin,lian gui,wei,kui jian,xian ye ai hui zhan jian gu zhao qu,ju,ji
+mei chou sao ning,cheng xun yao huo,xue,yue,wo meng mian pin mian lei kuang,guo jue xuan mian
+huo lu meng long guan,quan man xi,li chu tang kan zhu mao jin,qin,guan jin yu,jue,xu shuo
+ze,zhuo jue shi yi,xian shen zhi hou shen ying ju zhou jiao cuo duan ai jiao
+zeng yue ba shi,dan ding qi,diao ji zi gan,han wu zhe,da ku,qia gang,kong,qiang xi fan kuang
+dang ma sha dan jue li fu min e huo,hua,xu kang zhi qi,qie kan jie bin,fen,pin
+e ya pi zhe yan,xing sui zhuan che dun wa yan jin feng fa,jie,ge mo zha,zuo
+ju,zu yu ke,luo tuo tuo di,zhi zhai zhen e fu,fei mu zhu la,li bian nu ping
+peng,ping ling pao,bao,pu le po bo,e po shen za ai li long tong yong li kuang
+chu keng quan zhu kuang,guang gui,he e nao qia lu wei,hui,gui ai ge,luo,li xian,yin,ken,keng xing,keng yan
+dong,tong,liu peng,ping xi lao hong,gong shuo xia qiao qing wei qiao yi keng,qing xiao,qiao que,ke,ku chan
+lang hong yu xiao xia mang,bang luo,long yong,tong che che wo,e,yi liu,chu ying,geng mang que yan
+sha kun yu chi hua lu chen,cen jian nve song zhuo keng peng yan zhui,duo kong
+cheng qi zong,cong qing lin jun bo ding min,hun diao jian,zhan he lu,liu,luo ai sui que,xi
+leng bei yin dui wu qi lun wan dian nao,gang bei qi chen ruan yan die,she
+ding du,zhou tuo jie,ke,ya ying bian ke bi wei shuo zhen,an,kan duan xia dang ti,di nao
+peng jian,xian di tan cha tian qi dun feng xuan que que,qiao ma gong nian su,xie
+e ci liu si,ti tang bang,pang hua,ke,gu pi wei,kui sang lei cuo tian xia,qia,ya xi,qi lian,qian
+pan wei,ai,gai yun dui,zhui zhe ke la zhuan yao gun zhuan,tuan,tuo chan qi ao,qiao peng liu,lu
+lu kan chuang chen,ca yin lei biao qi mo qi,zhu cui zong qing chuo lun ji
+shan lao qu zeng deng jian xi lin,ling ding tan,dian huang,kuang,gong pan,bo za,she qiao,ao di li
+jian jiao xi zhang qiao dun jian,xian yu zhui he,qiao,ao ke,huo ze lei jie chu ye
+que,hu dang yi jiang pi pi yu pin e,qi ai,yi ke jian yu ruan meng pao
+ci bo yang ma ca xian,xin kuang,gong lei lei zhi li li,luo fan que pao ying
+li long long mo bo shuang guan lan ca yan shi,qi,zhi shi li reng she yue
+si qi,zhi ta ma xie yao xian qi,chi,zhi qi,gui zhi beng,fang dui zhong,chong ren yi shi
+you zhi tiao fu,fei fu mi,bi zu,jie zhi suan mei zuo qu hu zhu,zhou,chu shen sui
+ci,si chai mi,ni lv yu xiang wu tiao piao zhu gui xia zhi ji,zhai gao zhen
+gao shui,lei jin shen gai kun di dao huo tao qi gu guan zui ling lu
+bing jin dao zhi lu chan,shan bi zhe hui you xi yin zi huo zhen,zheng fu
+yuan wu xian yang,shang zhi yi mei si di bei zhuo zhen yong,ying ji gao tang
+si ma ta fu xuan qi yu xi ji,qi si chan,shan,tan dan gui sui li nong
+mi,ni,xian dao li rang yue ti zan lei rou yu yu li,chi xie qin he tu
+xiu si ren tu zi cha,na gan yi,zhi xian bing nian qiu qiu zhong,chong fen hao,mao
+yun ke miao zhi jing bi zhi yu mi,bi,bie ku ban pi ni li you zu,ju
+pi bo ling mo cheng,ping nian qin yang zuo zhi zhi shu ju zi huo ji,zhi
+cheng,chen tong zhi,shi huo,kuo he,ge yin zi zhi jie,ji ren du yi,chi zhu hui nong fu,bu,pu
+xi gao lang fu xun,ze shui lv kun gan jing ti cheng tu,shu shao shui,tuo,tui,tuan ya
+lun lu gu zuo ren zhun bang bai ji,qi zhi zhi kun leng,ling peng ke,hua bing,lin
+chou,tiao,diao zui,zu,su yu su lve xiang yi xi,qie bian ji fu pi,bi nuo jie zhong,chong zong
+xu cheng,chen dao wen xian,jian,lian zi,jiu yu ji,ze xu zhen,bian zhi dao jia ji,qi gao,kao,jiao gao
+gu rong sui rong ji kang mu can,shan,cen mei,men,mi zhi,chi,ti ji lu,jiu su ji ying wen
+qiu se he yi huang qie ji sui xiao,rao pu jiao zhuo,bo zhong,tong zui lv sui
+nong se hui rang nuo yu pin ji,zi tui wen cheng,bie huo,hu kuang lv biao,pao se
+rang,reng zhuo,jue li cuan,zan xue,jue wa,ya jiu qiong xi qiong,kong kong yu shen jing yao chuan,yuan
+zhun,tun tu lao qie zhai yao bian bao yao bing wa zhu,ku jiao,pao,liao,liu qiao diao wu
+gui,wa yao zhi,die chuang yao tiao jiao,zao chuang,cong jiong xiao cheng kou cuan wo dan ku
+ke zhuo xu su guan kui dou zhuo xun,yin wo wa ya,ye yu,dou ju qiong yao,qiao
+yao tiao chao yu tian diao ju,lou liao xi wu kui chuang zhao,ke kuan kuan,cuan long
+cheng cui liao zao cuan qiao qiong dou,du zao long qie li,wei chu shi fu qian
+chu hong qi hao sheng fen shu miao qu,kou zhan zhu ling long,neng bing jing jing
+zhang bai si jun hong tong,zhong song jing,zhen diao yi shu jing qu jie ping duan
+li zhuan ceng deng cun wai,hua jing kan jing zhu zhu,du le,jin peng yu chi gan
+mang zhu wan du ji jiao ba suan ji qin zhao sun ya zhui,rui yuan hu,wen,wu
+hang xiao cen,jin,han bi,pi bi jian yi dong shan sheng da,xia,na di zhu na chi gu
+li qie min bao tiao,shao si fu ce,shan ben fa da zi di ling ze,zuo,zha nu
+fu,fei gou fan jia gan fan shi mao po ti jian qiong long min bian luo
+gui qu chi yin yao xian bi qiong kuo deng xiao,jiao jin,qian quan sun,yun,xun ru fa
+kuang zhu tong,dong ji da hang ce zhong kou lai bi shai dang zheng ce fu
+yun,jun tu pa li lang ju guan jian,xian han tong,yong,dong xia zhi cheng suan shi zhu
+zuo xiao shao ting ce,jia yan gao kuai gan chou kuang gang yun ou,wu qian xiao
+jian pou,bu,fu,pu lai zou bi,bei,pai bi bi ge tai,chi guai,dai yu jian dao,zhao gu chi,hu zheng
+qing,jing,qiang sha,zha zhou lu bo ji lin suan jun,qun fu zha gu kong qian qian jun
+chui,zhui guan yuan,wan ce zu bo ze qie tuo luo dan xiao ruo,na jian xuan bian
+sun xiang xian ping zhen,jian xing,sheng hu yi,shi zhu,zhuo yue,yao,chuo chun lv wu dong shuo,xiao,qiao ji
+jie huang xing mei fan chuan,duan zhuan pian feng zhu huang,hong qie hou qiu miao qian
+gu kui shi lou yun,xun he tang yue chou gao fei ruo zheng gou nie qian
+xiao cuan long,gong,gan peng,pang du li bi,pi zhuo,huo chu shai,shi chi zhu qiang,cang long lan jian
+bu li hui,sui bi di,zhu cong yan peng can,cen,zan zhuan,suan,zuan pi piao,biao dou yu mie tuan,zhuan
+ze,zhai shai gui,guo yi hu chan kou cu,chuo,cou ping zao,chou ji gui su lou,lv,ju ce,ji lu
+nian suo cuan diao suo le duan zhu xiao bo mi shai,si dang,tang liao dan dian
+fu jian min kui dai jiao deng huang sun,zhuan lao zan xiao lu shi zan qi
+pai qi pai gan ju lu lu yan bo dang sai zhua,ke gou qian lian bu,bo
+zhou lai shi lan kui yu yue hao zhen,jian tai ti nie,mi chou,tao ji,jie yi qi
+teng zhuan,zuan zhou fan,ban,pan sou,shu zhou qian zhuo teng lu lu jian tuo ying yu lai
+long qie lian lan qian yue zhong qu,ju lian bian duan zuan li si luo ying
+yue zhuo yu mi di,za fan shen zhe shen nv he lei xian zi ni cun
+zhang qian zhai bi,pi ban wu sha,chao kang,jing rou fen bi cui yin zhe mi tai
+hu ba li gan ju po mo cu zhan,nian zhou chi su tiao li xi su
+hong tong zi,ci,ji ce,se yue zhou,yu lin zhuang bai lao fen er qu he liang xian
+fu liang can jing li yue lu ju qi cui,sui bai zhang lin zong jing,qing guo,hua
+hua san,shen san tang bian rou mian hou xu zong hu jian zan ci li xie
+fu nuo bei gu xiu gao tang qiu jia cao zhuang tang mi,mei san,shen fen zao
+kang jiang mo san san nuo xi liang jiang kuai bo huan shu zong xian nuo
+tuan nie li zuo di nie tiao,diao lan mi,si si jiu xi,ji gong zheng jiu,jiao you
+ji cha zhou xun yue,yao,di hong,gong,jiang yu,ou he,ge,jie wan ren wen wen qiu na zi tou
+niu fou ji,jie shu chun,zhun,tun,quan,zi pi,bi,chi zhen sha,miao hong zhi ji fen yun ren dan jin
+su fang,bang suo cui jiu za,zha ba jin fu zhi qi zi chou,zhou hong za,zha lei,lv,lie
+xi fu xie,yi shen bo,bi zhu,shu qu ling zhu shao,chao gan yang fu,fei tuo zhen,tian,jin dai
+chu shi zhong xian,xuan zu,qu jiong ban qu mo shu zui kuang jing ren hang xie,yi
+jie,ji zhu chou gua,kua bai,mo jue kuang hu ci huan,geng geng tao jie,xie,qia,jia,qi ku jiao,xiao quan
+gai,ai luo,lao xuan,xun beng,bing,peng xian fu gei,ji,xia dong,tong rong tiao,diao,dao yin lei xie juan xu,chu,nv,na gai,hai
+die tong si jiang xiang hui jue zhi jian juan,xuan chi,zhi mian,wen,man,wan zhen lv cheng qiu
+shu bang tong xiao,shao huan,wan qin,xian geng,bing xiu ti tou,xiu xie hong xi fu ting sui,shuai,rui,tuo
+dui kun fu jing hu zhi yan,xian jiong feng ji xu ren zong,zeng chen,shen,lin duo li,lie
+lv liang chou,tao,diao quan shao qi qi zhun qi wan qian,qing,zheng xian shou wei,yi qi,qing tao
+wan gang wang beng zhui,chuo cai guo cui,zu lun,guan liu qi,yi zhan bi chuo,chao ling mian
+qi qie tian,tan,chan zong gun,hun zou xi zi xing liang jin fei rui min yu zong,cong
+fan lv,lu xu ying shang qi xu xiang jian ke xian ruan mian ji,qi duan chong,zhong
+di min,mian,hun miao,mao yuan xie,ye bao si qiu bian huan geng cong mian wei fu wei
+tou,xu,yu gou miao xie lian zong bian,pian yun,gun yin ti gua zhi yun,wen cheng chan dai
+xia yuan zong xu sheng wei geng xuan ying jin yi zhui ni bang gu,hu pan
+zhou,chao,cu jian ci,cuo,suo quan shuang yun xia cui,sui,shuai xi rong tao fu yun chen,zhen gao ru,rong
+hu zai,zeng teng xian,xuan su zhen zong tao huang cai bi feng cu li suo,su yan,yin
+xi zong,cong lei juan,zhuan qian man zhi lv mu,mo piao lian mi xuan zong,cong ji shan,xian,xiao,sao,can
+sui,cui fan,po,pan lv beng yi sao,zao mou,jiu,miu,mu,miao,liao,lu yao,you,zhou qiang hun xian ji sha xiu ran xuan
+sui qiao,jue zeng,ceng zuo zhi shan san lin yu,jue fan liao,rao chuo zun jian rao chan
+rui xiu hui hua zuan xi qiang yun da sheng,ying,min hui,gui xi,ji se jian jiang huan
+zao,sao,qiao cong xie jiao,zhuo,he bi dan,tan,chan yi nong sui yi,shi shai xu,ru ji bin qian lan
+pu,fu xun zuan qi peng yao,li mo lei xie zuan kuang you xu lei xian chan
+jiao lu chan ying cai,shan rang,xiang,sang xian,jian zui zuan luo li,xi,sa dao,du lan lei lian si
+jiu yu hong,gong zhou xian,qian ge,he yue,yao ji wan kuang ji ren wei yun hong chun
+pi sha gang na ren zong lun,guan fen zhi wen fang zhu zhen niu shu xian
+gan xie fu lian zu shen xi zhi zhong zhou ban fu chu shao yi jing
+dai bang rong jie ku rao die hang hui gei,ji xuan jiang luo,lao jue jiao tong
+geng xiao juan xiu xi sui tao ji ti ji xu ling ying xu qi fei
+chuo,chao shang gun sheng wei mian shou beng chou tao liu quan zong,zeng zhan wan lv,lu
+zhui zi ke xiang jian mian lan ti miao ji,qi yun hui si duo duan bian,pian
+xian gou zhui huan di lv bian min yuan jin fu ru zhen feng cui gao
+chan li yi jian bin piao man lei ying suo,su mou,miao,miu sao xie liao shan zeng
+jiang qian qiao,sao huan jiao,zhuo zuan fou xie gang fou que,kui fou qi bo ping xiang
+zhao gang ying ying qing xia guan zun tan cheng qi weng ying lei tan lu
+guan wang wang gang wang han luo luo fu shen fa gu zhu,du ju,jie mao gu
+min gang ba gua ti,kun juan fu shen yan zhao zui gua,hua,guai zhuo yu zhi an
+fa lan,nan shu si pi ma liu ba,pi,bi,bai fa li chao wei bi ji zeng chong
+liu ji juan mi zhao luo pi ji ji luan yang mi,mie qiang da mei yang,xiang
+you you fen ba gao yang gu qiang,you zang gao,mei ling yi,xi zhu di xiu qiang
+yi xian,yan,yi rong qun qun qiang,qian huan suo,zui xian yi,xi yang qiang,kang qian,xian,yan yu geng jie
+tang yuan xi fan shan fen shan lian lei,lian geng,lang nou qiang chan yu,hu gong yi
+chong weng fen hong chi chi cui fu xia ben yi la yi pi,bi,po ling liu,lu
+zhi qu xi xie xiang xi xi ke qiao hui hui xiao,shu sha hong jiang di,zhai
+cui fei dao,zhou sha chi zhu jian xuan chi pian zong wan hui hou he,li he,hao
+han ao piao yi lian hou,qu ao lin pen qiao ao fan yi hui xuan dao
+yao lao lao kao mao zhe qi,zhi,shi gou gou gou die die er,neng shua ruan,nuo nai,er
+nai,neng duan,zhuan lei ting zi geng chao hao,mao yun ba,pa pi yi,chi si qu,chu jia ju
+huo chu lao lun ji,jie tang ou lou nou jiang pang zha,ze lou ji lao huo
+you mo huai er,reng yi ding ye,xie da,zhe song qin yun,ying chi dan dan hong geng
+zhi pan nie dan zhen che ling zheng you wa,tui,zhuo liao,liu long zhi ning tiao er,nv
+ya tie,zhe gua,guo xu lian hao sheng lie pin,ping jing ju bi di guo wen xu
+ping cong ding ni ting ju cong kui lian kui cong lian weng kui lian lian
+cong ao,you sheng song ting kui nie,zhe,she,ye zhi,te dan ning qie ni,jian ting ting long yu
+yu zhao si su yi,si su si,ti zhao zhao rou,ru yi le,lei,jin ji qiu ken cao
+ge,qi bo,di huan huang chi ren xiao ru zhou yuan du gang rong,chen gan cha wo
+chang gu zhi,shi han,qin fu fei,bi fen pei pang,feng jian,xian fang zhun,chun,tun,zhuo you na,nu ang,hang,gang ken
+ran gong yu,zhou,yo wen yao qi pi,bi qian,xu xi,bi xi fei,pei ken jing tai shen zhong
+zhang xie shen,chen wei zhou die dan,tan,da fei,bi ba bo qu tian bei gua,gu,hu tai zi,fei
+fei zhi ni ping,peng zi,ci,ji fu,zhou pang,pan zhen,zhun xian zuo pei jia sheng,xing,qing zhi,chi,di bao,pao mu
+qu hu ke chi yin xu yang long dong ka lu jing nu,nv yan pang kua
+yi guang hai,gai ge,ga dong chi,zhi jiao,xiao xiong xiong er an,e heng pian neng,tai,nai,xiong zi gui,kui
+cheng,zheng tiao zhi cui mei xie,xian,xi cui xie mai,mo mai,mo ji xie nin kuai sa zang
+qi nao mi nong luan,ji wan,wen bo wen wan,huan xiu jiao,jue jing,keng you heng cuo,qie lie,luan,pao
+shan,chan ting mei chun shen qian,qu,jie de,te juan,zui cu,ji xiu,you,tiao,xiao xin,chi tuo pao cheng nei,tui pu,fu
+dou tuo,tui niao nao pi gu luo li lian zhang,chang cui,sui jie liang,lang shui pi,pai,bi biao
+lun pian lei,guo,hua kui,quan,juan chui,hou,chuai dan tian nei jing nai la,xi ye yan,a,ang ren,dian shen chuo,zhui
+fu fu ju fei qiang,kong wan dong pi guo zong ding wo mei ni,ruan,nao,nen,er zhuan,dun,tu chi
+cou luo ou di an xing nao shu,yu shuan nan yun zhong rou e sai tu,dun
+yao jian,qian wei jiao,jue yu jia duan bi chang fu xian ni mian wa teng tui
+bang,pang qian,xian,yan lv wa shou tang su zhui ge yi bo,po,lie liao ji pi xie gao
+lv bin ou chang lu,biao guo,huo pang chuai biao,piao jiang fu,lu tang mo xi zhuan,chuan,chun lv
+jiao,hao,nao ying lv zhi xue cun lin,lian tong peng ni chuai,zha,zhai liao cui gui,kui,dui xiao teng,tun
+fan,pan zhi jiao shan hu,wu,mei cui run xiang sui,wei fen ying shan,dan zhua dan kuai nong
+tun lian bi,bei yong jue,ju chu yi juan la,ge lian sao tun gu qi cui bin
+xun nao,ru,er,nen,nuan wo,yue zang xian biao xing kuan la,lie yan lu,lv huo za luo qu zang
+luan ni,luan za,zan chen qian,xian,qin wo guang,jiong zang,cang lin guang,jiong zi jiao nie chou,xiu ji gao
+chou mian,bian nie zhi,die zhi,zhui ge jian die,zhi zhi,jin xiu tai zhen jiu xian yu,yong,kui cha
+yao yu chong,chuang,zhong xi xi,que,tuo jiu yu yu xing,xin ju jiu xin she,gua she,shi she jiu
+shi tan shu,yu shi tian,tan tan pu pu guan hua,qi tian chuan shun xia wu zhou
+dao chuan,xiang shan yi fan pa tai fan ban chuan,fan hang fang ban,pan,bo bi lu zhong
+jian cang ling zhu,zhou ze duo bo xian ge chuan xia lu qiong,hong pang,feng xi kua
+fu zao feng li shao yu lang ting yu wei bo meng nian,qian ju huang shou
+ke,jie,zong bian mu,mo die dao bang cha yi sou cang cao lou dai xue yao,tiao chong,zhuang,tong
+deng dang qiang lu yi ji jian huo,wo meng qi lu lu chan shuang gen,hen liang
+jian jian se,shai yan fu,bo,pei ping yan yan cao cao yi le,ji ting,ding jiao,qiu ai,yi nai,reng
+tiao jiao jie peng wan yi chai,cha mian mi gan qian yu,xu yu shao,xiao,que,di qiong,xiong du
+hu,xia qi mang,huang,wang zi hui,hu sui zhi xiang pi,bi fu tun,chun wei wu zhi qi shan,wei
+wen qian ren fu,fou kou jie,gai lu,hu xu,zhu ji qin,yin qi,chi yan,yuan fen ba,pa rui,ruo xin
+ji hua hua fang wu,hu jue gou zhi yun qin ao chu,zou mao ya fei,fu reng
+hang cong yin you bian yi qie wei li pi e xian chang cang zhu su
+ti,di yuan,yu,yun ran ling,lian tai shao,tiao di miao qing li,ji yong ke,he mu bei bao,pao,biao gou
+min yi yi ju,qu pie,pi ruo,re ku,gu,hu ning,zhu ni bo,pa bing shan,tian,chan xiu yao xian ben
+hong ying,yang zha,zuo dong ju,cha,zha,zu,jie,bao,xie die nie gan hu ping,peng mei fu,pu sheng,rui gu,gua bi,bie,mi wei
+fu,bo,fei,bei,bi zhuo,zhu mao fan jia,qie mao mao ba,pei,fei ci,zi,chai mo zi zhi,di chi ji jing long
+cong niao yuan xue ying qiong ge,luo ming li rong yin gen,jian qian,xi chai,zhi chen yu,wei
+hao,xiu,kou zi lie wu ji,duo gui ci jian,chong ci gou guang mang,huang cha,chi jiao,xiao,qiao jiao,niao fu
+yu zhu zi,ci jiang hui yin cha fa,pei,bo,ba rong ru chong mang,mu tong zhong qian zhu
+xun huan fu quan,chuo gai da,ta jing xing chuan cao,zao jing er an qiao chi ren
+jian ti,yi huang,kang ping,peng li jin lao,cha shu zhuang da jia rao bi ce qiao hui
+ji,qi dang zi rong hun,xun xing,ying luo ying xun,qian jin sun yin mai hong zhou yao
+du wei li dou fu ren yin he bi bu,pu yun di tu,cha,ye,shu sui,wei sui cheng
+chen,nong wu bie xi geng li pu,fu zhu mo li,chi zhuang zuo,ji tuo qiu sha,suo,sui suo
+chen peng,feng ju mei meng,xi,qing xing jing,ying che shen,xin jun yan ting you,diao,di cuo guan,wan han
+you,xiu cuo jia wang su,you niu,rou shao,xiao xian,wan lang,liang fu,piao e mo,mu wen,wan,mian jie nan mu
+kan lai lian shi wo tu xian huo you ying ying gong chun mang mang ci
+wan,yu,yun jing di qu dong jian,guan zou,cuan,chu,cong gu la lu,lv ju wei jun nie,ren kun he,ge
+pu zai,zi gao guo fu lun chang chou song chui zhan men cai ba li tu
+bo han bao qin juan xi,si qin di jie,sha pu,bei,bo dang jin qiao,zhao tai,zhi,chi geng hua,kua
+gu ling fei qin,jin an,yan wang beng zhou yan,yu ju,zu jian lin tan shu,jiao tian dao
+hu qi,ji he cui tao chun bi,pi,bei,ba chang huan fei,fu lai qi meng,ming ping wei dan
+sha huan,zhui yan,juan yi tiao qi wan ce nai zhen tuo jiu tie luo bi yi
+pan bo pao ding ying ying ying xiao sa qiu,jiao ke xiang wan yu,ju yu fu,bei
+lian xuan xuan nan ce wo chun xiao,shao,shuo yu bian,pian mao,mu an e luo,la,lao ying kuo,huo
+kuo jiang mian zuo,ze zuo zu bao rou xi ye,she an qu jian fu lv jing
+pen,fen feng hong hong hou yan tu zhu,zhe,zhuo,chu,zhao zi xiang ren,shen ge qia qing,jing mi huang
+shen,shan pu,bei gai dong,zhong zhou jian,qian wei bo wei pa ji hu zang jia,xia duan yao
+sui,jun,suo cong,chuang quan wei zhen,qian kui ting,ding hun,xun xi shi qi lan zong yao yuan mei
+yun shu di zhuan guan ran xue chan kai kui hua jiang lou wei,hua,kui,e pai you
+sou,hui yin shi chun shi yun zhen lang ru,na meng li que suan yuan,huan li ju
+xi bang,pang chu xu,shu tu liu huo,wo dian qian zu,ju,ji po cuo yuan chu yu kuai
+pan pu pu,bo na shuo xi fen yun zheng jian ji ruo cang en mi hao,gao
+sun zhen,qin ming,mi sou xu liu xi gu lang rong weng gai,ge cuo shi tang luo
+ru suo,sui xuan bei yao,zhuo gui bi zong gun zuo tiao ce pei lan,la dan ji
+li shen lang yu ling ying mo diao,tiao,di tiao,xiu mao tong chu,zhu peng an lian cong,zong,song
+xi ping qiu,ou,xu,fu jin chun,tuan jie wei tui cao yu yi zi,ju liao,lu,lao,liu bi lu xu,su
+bu zhang lei qiang,jiang man yan ling ji,xi biao,piao gun han di su lu,cu she shang
+di mie xun man,wan bo di,dai,chai cuo,cu,zha zhe shen,san xuan wei,yu hu ao mi lou,lv,ju,liu cu,cou,chuo
+zhong cai,sa,ca po,bo jiang mi cong niao hui juan,jun yin jian,shan nian,yan shu yin guo chen
+hu sha kou qian ma zang,cang ze qiang dou lian lin kou ai bi,bie,pie li wei
+ji qian,tan,xun sheng fan,bo,pi meng ou chan dian xun,tan jiao,qiao rui,juan rui lei yu qiao,jiao chu
+hua jian mai yun bao you qu lu rao,yao hui e ti fei jue,zui zui,jue,zhuo fa,fei
+ru fen,fei kui,kuai shun rui ya xu fu jue dang,tang wu dong si xiao xi long
+wen,yun shao qi jian yun sun ling yu xia weng,yong ji,qie hong si nong lei xuan
+yun yu,ao xi,xiao hao bao,bo,bu hao ai wei hui hui ji ci,zi xiang wan,luan mie yi
+leng jiang can shen qiang,se lian ke yuan da ti,zhi tang xue bi,bo,bai,pi zhan sun xian,lian,yan,kan
+fan ding xie gu xie shu,zhu jian hao,kao hong sa xin xun yao bai sou shu
+xun dui pin wei,yuan ning chou,zhou,dao mai,wo ru piao tai ji,ci,qi zao chen zhen er ni
+ying gao cong xiao,hao,he qi fa jian xu,yu kui ji,jie bian diao,di,zhuo mi lan,la jin cang,zang
+miao,mo qiong qie xian liao ou xian,qian su lv yi xu xie li yi la lei
+jiao di zhi bei teng yao,shuo,lve mo huan biao,pao fan sou,shu,cou tan tui qiong qiao wei
+liu hui ou gao yun,wen bao li shu,zhu chu,zhu,zha ai lin zao xuan qin lai huo,he
+tuo,ze wu,e rui rui qi,ji,qin heng lu su tui meng,mang yun ping,pin yu xun ji jiong
+xuan mo qiu su jiong peng nie,bo bo,bi rang,xiang,nang yi xian yu ju lian lian,xian yin
+qiang ying long tou hua yue ling qu,ju yao fan mei han,lan kui,hui,gui lan ji dang
+man lei lei hui feng,song zhi wei kui zhan huai li ji mi lei huai luo
+ji kui lu jian sa teng lei quan xiao yi luan men bie hu hu lu
+nve lv,bi si,xi,ti,zhi xiao qian chu,ju hu xu cuo fu xu xu lu hu yu hao
+jiao,hao ju guo bao yan zhan zhan kui bin xi,se shu chong,hui qiu diao,dao ji qiu
+ding,cheng shi xia jue zhe she,ye yu han,gan zi hong,jiang,gong hui meng ge sui xia,ha chai
+shi yi ma xiang fang,bang e ba chi qian wen wen rui bang,beng,pi,feng pi yue yue
+jun qi tong yin qi,zhi can,tian yuan,wan jue,que hui,you qin,qian qi zhong ya hao,ci mu wang
+fen fen hang gong,zhong zao,zhao fu ran jie fu chi dou bao,pao xian ni dai qiu
+you,zhu zha ping chi,di you,niu he,ke han ju li fu ran,tian zha gou,qu,xu pi pi,bo xian
+zhu diao bie bing gu zhan qu,ju she,yi,tuo,chi tie ling gu dan gu ying li cheng
+qu mou,mao ge,luo ci hui hui mang,bang fu yang wa,jue lie zhu yi xian kuo,she jiao
+li yi,xu ping qi,jie,qie ha,ge,e she yi wang mo qiong,gong qie,ni gui qiong zhi man lao
+zhe jia nao si qi xing jie qiu shao,xiao yong jia tui che bei e,yi han
+shu xuan feng shen shen,zhen fu,pu xian zhe wu fu li lang,liang bi chu,yu yuan,xuan you
+jie dan yan,dan ting,dian dian tui,yue hui wo zhi song fei,pei,bei ju mi qi qi yu
+jun la,qu,zha,ji meng qiang si,xi xi lun li die tiao,diao tao kun han han yu,guo bang
+fei pi,miao wei dun,tun yi,xi yuan,yun suo quan,juan qian rui,wei ni qing,jing wei,tong liang guo,luo wan
+dong e ban di,zhuo wang can yang ying guo chan ding la ke jie,ji xie,he ting
+mao xu,xie mian yu jie shi,li,long xuan huang yan bian,pian rou,nao wei fu yuan mei wei
+fu ru,ruan xie you qiu,you,jiu mao,wu xia,ha,jia ying shi chong,zhong tang zhu zong ti,chi fu yuan
+kui meng la du,dai hu qiu die,tie li,xi wo,luo,guo yun,ao qu,yu nan lou chun rong ying
+jiang ban lang pang,bang si xi,ci ci xi,qi yuan weng lian sou ban,pan,huan rong rong ji
+wu xiu han qin yi,si bi,pi hua tang yi du nai,neng he,xia hu gui,hui ma ming
+yi wen ying te,teng zhong cang sao qi man tiao shang shi,zhe cao chi di,dai ao
+lu wei zhi,die tang chen piao qu,ju pi yu jian,chan luo lou qin zhong yin jiang
+shuai wen xiao wan zhe zhe ma,mo ma guo,yu liu,liao mao,meng xi cong li man xiao
+chang zhang mang,meng xiang mo zui si qiu te zhi peng peng jiao,qiao qu bie liao
+pan,fan gui xi ji,qi zhuan huang fei,ben lao,liao jue jue hui yin,xun chan,ti,shan jiao shan nao,rao
+xiao wu,mou chong,zhong,tong xun si chu cheng dang li xie shan,dan,chan,tuo yi,ji jing da chan qi,ji
+ci,ji xiang she luo,guo qin ying chai li zei xuan lian zhu ze xie mang xie
+qi rong jian meng hao ru huo,yue zhuo jie pin he mie fan lei jie la
+min,mian li,luo chun li qiu nie lu du xiao zhu,chu long li long feng,pang ye pi
+nang,shang,rang gu,ye juan ying shu xi can qu quan,huan du can man qu,jue jie zhu,shu zhuo
+xue,xie huang nv pei,fou nv xin zhong mai er ka mie xi xing,hang,heng yan kan yuan
+qu ling xuan shu xian tong,dong xiang,long jie xian,yu ya,yu hu wei dao chong wei dao
+zhun heng qu yi yi bu gan yu biao cha yi shan chen fu gun fen,pen
+shuai,suo,cui jie na zhong dan yi zhong zhong jie zhi,ti,qi xie ran zhi ren qin jin,qin
+jun yuan mei,yi chai ao niao hui ran jia tuo ling dai bao,pao pao,bao yao zuo
+bi shao tan,zhan ju,jie he,ke,kua xue xiu zhen yi,tuo pa bo,fu di wa,mo fu gun zhi
+zhi ran pan,fan yi mao,mou tuo na,jue gou xuan zhe,chan qu bei,bi,pi yu xi mi bo
+bo fu chi,nuo chi,qi,duo,nuo ku ren jiang jia,qia,jie jian,zun bo,mo jie er ge,luo ru zhu gui,gua
+yin cai lie ka xing zhuang dang xu kun ken niao shu jia,xie kun cheng li
+juan shen pou,bao ge,jie yi yu zhen liu qiu qun ji yi bu zhuang shui sha
+qun li lian,shao lian ku jian fou chan,tan bi,pi kun tao yuan ling chi chang chou,dao
+duo biao liang shang,chang pei,fei pei fei yuan,gun luo guo yan,an du ti,xi zhi ju yi,qi
+qi guo gua ken qi ti ti,shi fu chong,zhong xie bian,pian die kun duan,tuan xiu,you xiu
+he yuan bao bao fu yu,tou tuan yan hui,yi bei chu,zhe,zhu lv pao dan yun,wen ta
+gou da huai rong yuan ru,nu nai jiong suo,cha ban,pan tui,tun chi sang niao ying jie
+qian huai ku lian lan li zhe,die,xi shi lv yi,nie die xie xian wei biao cao
+ji qiang sen,shan bao,pou xiang bi fu,pu jian zhuan,juan jian cui,cuo ji dan za fan,bo bo,fei
+xiang xin bie rao man lan ao ze,duo,yi gui,hui cao sui nong chan,dan lian,chan bi jin
+dang shu,du tan,zhan,chan bi lan fu ru zhi dui shu wa shi bai,bei xie bo chen
+lai long xi xian,shan lan zhe dai ju zan,cuan shi jian pan yi lan ya xi
+xi yao feng,ban tan,qin,yan fu fiao fu ba he ji ji jian,xian guan bian yan gui,xu
+jue pian mao mi mi mie,pie shi si chan,dan,ji luo jue mi tiao lian yao zhi
+jun xi shan wei xi tian yu lan e du qin,qing pang ji ming ying gou
+qu zhan jin guan deng jian,bian luo,luan qu jian wei jue,jiao qu luo lan shen di,ji
+guan jian,xian guan yan gui mi shi chan lan jue,jiao ji xi di tian yu gou
+jin qu jiao,jue,lu,gu qiu jin cu,chu,cheng jue,kui,gui zhi chao ji gu dan zi,zui di,zhi shang hua,xie
+quan ge shi jie,xie gui gong chu jie hun qiu xing su ni ji,qi lu zhi
+zha,da bi xing hu,que,jue shang gong zhi xue,hu chu xi yi li,lu jue xi yan xi,wei
+yan,yin yan ding fu qiu,kao qiu jiao hong,jun,heng ji fan xun diao hong chai,cha tao xu
+jie,ji yi,dan,shi,tuo ren xun yin shan qi tuo ji xun yin e fen,bin ya yao song
+shen yin xin,xi,yin jue xiao,na ne chen you zhi xiong fang xin chao,miao she yan sa
+zhun xu,hu yi yi su chi he shen he xu zhen zhu zheng gou zi zi
+zhan,che,dian,tie gu fu jian die ling di,ti yang li nao,na,nu pan zhou gan yi ju yao
+zha yi,tuo,duo,xi yi,dai,tai qu zhao ping bi xiong qu,chu ba,bo da zu tao zhu ci zhe
+yong xu xun yi huang he,ge shi cha,qie xiao shi hen cha,du gou,hou gui quan hui
+jie hua gai xiang,yang wei shen zhou,chou tong,dong mi zhan,dan ming e,lve,luo hui yan xiong gua
+er,chi bing tiao,diao yi,chi,duo lei zhu kuang kua,qu wu yu teng ji zhi ren cu lang
+e kuang ei,xi,yi,e shi ting dan bei chan you keng qiao qin shua an yu xiao
+cheng jie xian wu wu gao song bu hui jing shuo zhen shuo,shui,yue,tuo du hua chang
+shui,shei jie ke qu,jue cong xiao sui wang xian fei chi,lai ta yi ni,na yin diao,tiao,zhou
+pi,bei zhuo chan chen zhun ji qi tan zhui wei ju qing dong zheng ze,cuo,zuo,zha,jie zou,zhou
+qian zhuo liang jian chu,ji hao,xia,huo lun shen,nie biao hua pian yu die,xie xu pian shi,di
+xuan shi hun hua,gua e zhong di,ti xie fu pu ting jian,lan qi yu,tou zi zhuan
+xi,shai,ai hui yin an,tou xian,gan nan chen feng zhu,chu yang yan huang xuan ge nuo qi,xu
+mou ye,ai wei xing teng zhou,chou,chao shan jian po,pao kui,dui,tui,gui huang huo ge ying,hong mi xiao,sou
+mi xi,xia qiang chen,zhen xue ti,si su bang chi qian,zhan shi,yi,xi jiang yuan,quan xie he,xiao tao
+yao yao lu yu,xu biao,piao cong qing li mo mo shang zhe,ze miu jian ze jie,zha,zu
+lian lou,lv can,zao,san,chen ou,xu gun xi,che zhuo,shu,zhe ao ao jin zhe yi,chi hu,xiao jiang man chao
+han,xian hua,wa chan,dan xu zeng se xi zha dui zheng nao,xiao lan e,wa,gui ying jue ji
+zun jiao,qiao bo hui zhuan,quan wu,mo zen,jian zha shi,zhi qiao tan zen pu sheng xuan zao
+tan dang sui xian ji jiao jing zhan,lian nang,nou yi ai zhan pi hui hua,xie,hui yi
+yi shan rang nou qian dui ta hu zhou,chou hao ai,yi,ni ying jian yu jian hui
+du,dou zhe xuan zan lei shen wei chan li yi,tui bian zhe yan e chou wei
+chou yao chan rang yin lan chen,chan xie nie huan zan yi dang zhan yan du
+yan ji ding fu ren ji jie hong tao rang shan qi tuo xun yi xun
+ji ren jiang hui ou ju ya ne xu,hu e lun xiong song feng she fang
+jue zheng gu he ping zu shi,zhi xiong zha su zhen di zhou ci qu zhao
+bi yi yi kuang lei shi gua shi ji,jie hui cheng zhu shen hua dan gou
+quan gui xun yi zheng gai xiang cha hun xu zhou jie wu yu qiao wu
+gao you hui kuang shuo,shui,yue song ei qing zhu zou nuo du,dou zhuo fei ke wei
+yu shui,shei shen diao,tiao chan liang zhun sui tan shen yi mou chen die huang jian
+xie xue ye wei e yu xuan chan zi an yan di mi,mei pian xu mo
+dang su xie yao bang shi qian mi jin man zhe jian miu tan zen qiao
+lan pu jue yan qian zhan chen gu,lu,yu qian hong xia ji hong han hong,long xi,ji
+xi huo,hua liao han,gan du long dou jiang qi,kai shi,chi li,feng deng wan bi,bian shu xian
+feng zhi zhi yan yan shi chu hui tun yi tun,dun yi jian ba hou e
+chu xiang huan jian,yan ken,kun gai ju fu,pu xi bin,huan hao yu,xie,shu zhu jia fen xi
+bo,hu,huo,gou wen huan bin,ban di zong fen yi zhi,zhai bao chai an pi na pi gou
+na,duo you diao mo si xiu huan kun,mao,ken he,mo hao,he,mo,ma mo,ma an mao,mo li,mai,yu ni bi
+yu jia tuan mao pi xi yi ju,yu mo chu tan huan jue bei zhen,zheng yuan
+fu cai gong te yi hang wan pin huo fan tan guan,wan ze,zhai zhi er zhu
+shi bi zi er gui pian bian,fa mai dai,te sheng kuang fei,fu,bi tie yi chi mao
+he bi,fen,ben,fei,ban,lu,pan lu lin hui gai pian zi jia,gu xu zei jiao gai zang jian ying
+xun zhen she,sha bin bin qiu she chuan zang zhou lai zan ci chen shang tian
+pei geng xian mai jian sui fu tan cong cong zhi ji zhang du jin xiong
+chun yun bao zai lai feng cang ji sheng yi,ai zhuan,zuan fu gou sai ze liao
+yi bai chen wan zhi zhui biao yun,bin zeng dan zan yan pu shan,dan wan ying
+jin gan xian zang bi du shu yan shang xuan long gan,gong,zhuang zang bei zhen fu
+yuan gong cai ze xian bai zhang huo zhi fan tan pin bian gou zhu guan
+er jian ben,bi shi tie gui kuang dai mao fei he yi zei zhi jia,gu hui
+zi lin lu zang zi gai jin qiu zhen lai she fu du ji shu shang
+ci bi zhou geng pei dan lai feng zhui fu zhuan,zuan sai ze yan zan yun
+zeng shan ying gan chi xi she,ce nan tong,xiong xi cheng he,shi cheng zhe xia tang
+zou zou li jiu fu zhao gan,qian qi shan qiong yin,qin xian zi jue,gui qin chi,di
+ci chen,zhen,nian chen die,tu ju,qie chao,tiao di xi zhan jue,ju yue,huo qu ji,jie chi,qu chu gua,huo
+xue,chi zi,ci tiao duo lie gan suo cu xi zhao,diao su yin ju,qu,qiu jian que,qi,ji tang,zheng,cheng
+chuo,chao,tiao,zhuo cui,wei,ju lu qu,cu,cou,zou dang qiu,cu zi ti qu,cu,cou chi huang,guang qiao,jiao,chao qiao jiao zao ti,yue,yao
+er zan zan,zu zu,ju pa bao,bo,zhuo,chuo,pao ku,wu ke dun jue,gui fu chen jian,yan fang,pang zhi ta,sa,qi
+yue ba,pa qi,ji,zhi yue qiang tuo,chi tai yi nian,jian,chen,tian ling mei ba,bei die,tu ku tuo jia
+ci,zi pao,bo qia zhu ju,qu dian,tie,die,zhan zhi fu pan,ban ju,qu,qie,zhu shan bo,bi,po ni ju li,luo gen
+yi ji duo,dai,chi xian,sun jiao,qiao duo zhu,chu quan,zun kua,ku zhuai,shi gui qiong,qiang kui,xie xiang chi,die lu,luo
+pian,beng,bing zhi jia,jie tiao,diao,tao cai jian da qiao bi xian duo ji ju,qu ji shu,chou tu,duo,chuo
+chu,cu jing,keng nie xiao,qiao bu xue,chi cun,qun,zun,qiu,zhun mu shu liang,lang yong jiao chou qiao mou ta
+jian qi,ji wo,wei,rui wei,cu chuo,diao,zhuo,tiao jie ji,qi,que nie ju nie lun lu leng,cheng huai ju chi
+wan,wo quan,juan ti,die bo,pou zu,cu,cui qie yi,qi,ji cu,di zong cai,kui zong peng,pan zhi zheng dian zhi
+yu,yao,chu duo,chuo dun chuan,chun yong zhong di,zhi,ti,chi,shi zha chen chuai,shuan,duan,chuan jian gua,tuo tang,shang ju fu,bi zu
+die pian rou nuo,re,na ti,di cha,zha tui jian dao cuo qi,xi ta qiang nian,zhan,chan dian ti
+ji nie pan,man liu zan,can bi chong lu liao cu tang,cheng dai,die,dan,zhi su xi kui ji
+zhi,zhuo qiang di,zhi pan,man,liang zong lian beng zao nian,ran bie tui ju deng ceng xian fan
+chu zhong,chong dun,zun,cun,cuan,qun bo cu,zu,jiu cu jue,gui jue lin ta qiao jue,qiao,jiao,ju,xue pu liao dun cuan
+guan zao da bi bi zhu,zhuo ju chu,chuo qiao dun chou ji wu yue,ti nian lin
+lie zhi li,yue,luo zhi chan,zhan chu duan wei long lin xian wei zuan lan xie rang
+sa,xie nie ta qu ji cuan cuo,zuan xi kui jue,qi lin shen,juan gong dan fen qu
+ti duo duo gong lang ren luo ai ji ju tang kong lao yan mei kang
+qu lou,lv lao duo,tuo zhi yan ti dao ying yu che,ju ya,zha,ga gui jun wei yue
+xin,xian dai xuan,xian,han,jian fan ren shan kuang shu tun chen,qi dai e na qi mao ruan
+kuang qian zhuan hong hu qu,gou,ju kuang di,chi ling dai ao zhen fan,ben kuang yang peng
+bei gu gu pao zhu rong,fu e ba zhou,zhu zhi yao,diao ke yi,die,zhe zhi,qing shi ping
+er gong ju jiao,jue,xiao guang he,lu,ya kai quan,chun zhou zai,dai,zi zhi she liang yu shao you
+wan,yuan yin,qun zhe wan fu qing zhou ni,yi leng,ling zhe zhan liang zi hui wang chuo
+guo,hua,hui kan yi peng qian gun nian,lian ping,peng guan bei lun pai liang ruan,er rou ji
+yang xian,kan chuan cou chun,shun ge,ya,e,qie you hong shu fu,bu zi fu wen,yun ben zhan,nian yu
+wen tao,kan gu zhen xia,he yuan lu jiao,xiao chao zhuan,zhuai wei hun xue zhe jiao zhan
+bu lao,liao fen fan lin ge se kan huan yi ji zhui er yu jian hong
+lei pei li li lu lin che,ju ya,zha,ga gui xuan dai ren zhuan,zhuai e lun ruan
+hong gu ke lu zhou zhi yi hu zhen li yao qing shi zai zhi jiao
+zhou quan lu jiao zhe fu liang nian bei hui gun wang liang chuo zi cou
+fu ji wen shu pei yuan xia nian,zhan lu zhe lin xin gu ci ci pi,bi,mi
+zui bian la la ci xue,yi ban bian bian,ban,pian bian xue bian ban ci bian bian,pian,ban
+chen ru nong nong chan,zhen chuo chuo yi reng bian bian shi yu liao da,ti,ta chan
+gan qian yu yu qi xun yi,tuo guo mai qi za wang,guang,kuang tu zhun ying da
+yun jin hang,xiang ya fan wu da e hai,huan,fu zhe,zhei da jin yuan wei lian chi
+che ni,chi tiao zhi,chi yi,tuo jiong jia,xie chen dai er di po,pai zhu,wang die,yi,da ze,zuo tao
+shu tuo,yi qu jing hui dong you mi beng ji nai yi jie zhui,dui,tui lie xun
+tui song shi,kuo tao pang,feng hou ni dun jiong xuan xun bu you xiao qiu tou,shu
+zhu,di,zhou,tun qiu di di tu jing ti dou,zhu,tou,qi yi,si zhe,yan,zhei tong guang,kuang wu shi cheng,ying su
+zao,cao qun,xun,suo feng,peng,pang lian,lan suo hui li gu lai ben cuo jue,zhu beng,peng huan dai,di lu,dai
+you zhou jin yu chuo kui wei ti yi da yuan luo bi nuo yu,dou dang,tang
+sui dun,qun,xun sui yan,an chuan chi ti yu,yong,ou shi zhen you yun e bian guo,huo e
+xia huang qiu dao da,ta wei,hui nan yi,wei gou yao chou liu xun ta di,shi,dai chi,zhi,xi
+yuan su ta qian ma yao guan zhang ao shi,di,ti,zhe ca chi su zao zhe dun
+di,shi,dai lou chi,zhi cuo lin zun rao qian xuan,suan,shua yu yi,wei,sui e liao ju,qu shi bi
+yao mai xie sui hai,huan,xuan zhan teng er miao bian bian la,lie li,chi yuan yao luo
+li yi,e ting deng,shan qi yong shan han yu mang ru,fu qiong xi kuang fu kang,hang
+bin fang xing,geng na,nuo,nei,ne,nai xin shen bang yuan cun huo xie,ya,ye,xu,she bang wu ju you han
+tai qiu bi,bian pi bing shao bei wa di zou ye,qiu lin kuang gui zhu shi
+ku yu gai,hai he,xia qie,xi zhi,ji ji huan,xun hou xing jiao xi gui nuo,na,fu lang jia
+kuai zheng lang yun yan cheng dou xi,chi lv fu wu,yu fu gao hao,shi lang jia
+geng jun ying,cheng bo xi bei li yun bu,pou xiao,ao qi pi qing guo zhou tan
+zou,ju ping lai,lei ni chen,lan you,chui bu xiang dan ju yong qiao yi dou,du yan mei
+ruo bei e shu juan yu yun hou kui xiang xiang sou tang ming xi ru
+chu zi zou,ju ye wu xiang yun hao,qiao,jiao yong bi mao,mo chao fu,lu liao yin zhuan
+hu qiao yan zhang man,wan qiao xu deng bi xun bi zeng,ceng wei zheng mao shan
+lin po,pi,pan dan,duo meng ye cao,sao kuai feng meng zou,ju kuang,kuo lian zan chan you ji,qi
+yan chan cuo,zan ling huan,quan xi feng zan,cuo li,zhi you ding qiu zhuo pei zhou yi
+gan,hang yu jiu yan,yin zui mao zhen,dan xu dou zhen fen yuan fu yun tai tian
+qia tuo,duo cu,zuo han gu su po,fa chou zai,zui ming lao,luo,lu chuo chou you tong,dong,chong zhi
+xian jiang cheng yin tu jiao mei ku suan lei pu zui,fu hai yan shai,shi niang
+wei,zhui lu lan yan,ang tao pei zhan chun tan,dan zui zhui cu,zuo kun ti xian,jian du
+hu xu xing,cheng,jing tan qiu,chou chun yun po ke sou mi quan,chuo chou cuo yun yong
+ang zha hai tang jiang piao chen,chan yu,ou li zao lao yi jiang bu jiao,qiao,zhan xi
+tan fa,po nong yi,shi li ju yan,lian,xian,jian yi,ai niang ru xun chou,shou,dao yan ling mi mi
+niang xin jiao shai,shi,li mi yan bian cai shi you shi shi,yi li zhong,chong,tong ye,shu liang
+li,xi,lai,tai jin jin qiu,ga yi liao dao zhao ding,ling po qiu ba fu zhen zhi ba
+luan fu nai diao shan,xian qiao,jiao kou chuan zi fan hua,yu hua,wu han,gan gang,gong qi mang
+ri,ren,jian di si xi yi chai,cha shi,yi,ye tu xi nv qian qiu jian pi,zhao ye,ya jin,yin
+ba,pa fang chen,qin,zhen xing dou yue qian,zhong fu pi,bu na,rui xin,qin e jue dun gou yin
+qian,han ban sa,xi ren chao niu,chou fen yun,dui yi qin pi,bi guo hong yin jun diao
+yi zhong xi gai ri huo tai kang yuan lu e qin duo zi ni tu
+shi min gu,pi ke ling bing si,ci,tai gu,hu bo pi yu si zuo bu you,zhou tian,dian
+jia,ge zhen shi shi,zu zhi,tie ju chan,qian,tie shi,yi shi,she,yi,tuo,ta xuan zhao bao,pao he bi,se sheng chu,zu,zhu,ju,cha,xu
+shi,zu bo zhu chi za po tong qian,an fu zhai liu,mao qian,yan fu li yue pi
+yang ban bo jie gou,qu shu,xu zheng mu xi,ni,nie xi,nie di jia mu tan huan,shen yi
+si kuang ka bei jian tong,zhuo xing hong jiao chi er,keng luo,ge bing,ping shi mou,mao jia,ge,ke,ha
+yin jun zhou chong xiang,jiong tong mo lei ji yu,si xu,hui ren zun zhi qiong shan,shuo
+chi,li xian,xi xing,jian quan pi tie,yi zhu xiang,hou ming kua yao,diao,tiao,qiao xian,tian,gua xian xiu jun cha
+lao ji pi ru mi yi yin guang an diu you se kao qian luan si
+ai diao han rui shi,zhi keng qiu xiao zhe,nie xiu,you zang ti cuo gua hong,gong zhong,yong
+tou,dou,tu lv mei,meng lang wan xin,zi yun,jun bei wu su yu chan,yan ding,ting bo han jia
+hong cuan,jian,juan feng chan wan zhi si,tuo xuan,juan hua,wu,hu yu,wu tiao kuang zhuo,chuo lve xing,jing qin,qian,jin
+shen han lve ye chu,ju zeng ju xian tie,e mang pu li pan rui,dui,yue cheng gao
+li te bing zhu zhen tu liu zui,nie ju chang yuan,wan jian gang diao tao chang
+lun,fen guo,kua,ke ling pi lu li qiang pou,fu,pei juan min zui,zu peng,beng an pi,bei,bi xian,gan,qian ya
+zhui lei,li ke,a kong ta kun,gun du nei,zhui,wei chui zi zheng ben nie zong chun,dui,duo tan,xian,yan
+ding qi,yi qian,jian zhui,chuo ji yu jin guan mao chang tian,tun xi,ti lian tao,diao gu cuo,cu,xi
+shu zhen lu,lv meng lu hua biao ga lai ken fang wu nai wan,jian zan hu
+de xian pian huo liang fa men kai,jie ying di,chi,shi lian,jian guo xian du tu wei
+zong fu rou ji e jun chen,zhen ti zha hu yang duan xia yu keng sheng
+huang wei fu zhao cha qie shi,she hong kui tian,nuo mou qiao qiao hou tou cong
+huan ye,xie min jian duan jian song,si kui hu xuan duo,du,zhe jie zhen,qian bian zhong zi
+xiu ye mei pai ai jie qian mei suo,cha da,ta bang,pang xia lian suo,se kai liu
+yao,zu ye,ta,ge nou,hao weng rong tang suo qiang,cheng li,ge shuo chui,dui,zhui bo pan da,sa bi,pi sang
+gang zi wu ying,jiong huang tiao liu kai sun sha,shi,se sou wan hao,gao zhen zhen,tian lang,luo
+yi yuan tang nie xi jia ge ma juan song zu suo xia feng wen na
+lu suo ou,kou zu,chuo tuan xiu guan xuan lian shou,sou ao man mo luo bi wei
+liu,liao di san,qiao,can zong,cong yi lu,ao ao,biao keng qiang cui qi chang tang man yong chan
+feng jing biao shu lou,lv xiu cong long zan jian,zan cao li xia xi kang shuang
+beng zhang qian cheng lu hua ji pu hui,sui,rui qiang po lin se xiu san,xian,sa cheng
+kui,gui si liu nao huang pie sui fan qiao quan yang tang xiang jue,yu jiao zun
+liao qie lao dui,dun xin zan ji,qi jian zhong deng ya ying dui,dun jue nou zan,ti
+pu tie fan cheng ding shan kai jian fei sui lu juan hui yu lian zhuo
+qiao,sao,cao jian,qian zhuo,shu lei bi,bei tie,die huan,xuan ye duo guo dang,cheng,tang ju,qu fen,ben da bei yi
+ai zong xun diao zhu heng zhui ji nie,ni he huo qing bin ying kui ning
+xu,ru,rou jian jian qian cha zhi mie,mi li lei ji zuan kuang,gong shang peng la du
+shuo,yue,li chuo lv biao bao lu xian kuan long e lu xin,xun jian lan bo jian,qian
+yao,yue chan xiang,rang jian xi,hui guan cang nie lei cuan qu pan luo zuan luan zao,zuo,zu
+nie,yi jue tang zhu lan jin ga yi zhen ding zhao po liao tu qian chuan
+shan sa fan diao men nv yang chai xing gai bu tai ju dun chao zhong
+na bei gang ban qian yao,yue qin jun wu gou kang fang huo tou,dou niu ba,pa
+yu qian zheng qian gu bo ke po bu bo yue zuan mu tan jia dian,tian
+you tie bo ling shuo qian,yan mao bao shi xuan ta,tuo bi ni pi duo xing
+kao lao er mang ya you cheng jia ye nao zhi dang,cheng tong lv diao yin
+kai zha zhu xi,xian ding,ting diu xian hua quan sha ha diao,yao ge ming zheng se
+jiao yi chan chong tang an yin ru zhu lao pu wu,yu lai te lian keng
+xiao suo li zeng chu guo gao e xiu cuo lve feng xin liu kai jian
+rui ti lang qin ju a qiang zhe nuo cuo mao ben qi de ke kun
+chang xi gu luo chui zhui jin zhi xian juan huo pei tan,xian ding jian ju
+meng zi qie ying kai qiang si e cha qiao zhong duan sou huang huan ai
+du mei lou zi fei mei mo zhen bo ge nie tang juan nie na liu
+gao,hao bang yi jia bin rong biao tang man luo beng yong jing di zu xuan
+liu chan,tan,xin jue liao pu lu dui,dun lan pu cuan qiang deng huo lei huan zhuo
+lian yi cha biao la chan xiang zhang,chang chang jiu ao die qu liao mi zhang,chang
+men ma shuan shan huo,shan men yan bi han,bi bi shan kai,qian kang beng hong run
+san xian xian,jian jian min xia shui dou zha,ya,ge nao zhan peng xia,e ling bian,guan bi
+run ai,he,hai,gai,kai guan ge ge,he fa chu hong,xiang gui min se kun lang,liang lv ting sha
+ju yue yue chan qu lin chang,tang shai,sha kun yan wen yan e,yu,yan hun yu wen
+hong bao hong,xiang,juan qu yao wen ban,pan an,yin wei yin kuo que,jue,kui lan du,she quan feng
+tian nie ta kai he que,jue chuang,chen guan dou qi kui tang,chang guan,wan piao kan,han,xian xi,se,ta
+hui chan pi dang,tang huan ta wen ta men shuan shan yan han bi wen chuang
+run wei xian hong jian min kang men zha nao gui wen ta min lv kai
+fa ge he kun jiu yue lang du,she yu yan chang xi wen hun yan e,yan
+chan lan qu hui kuo que he tian da,ta que han,kan huan fu fu le dui
+xin qian wu,wei gai,yi zhi,yi,tuo yin yang dou e,ai sheng ban pei keng,kang,gang yun,yan ruan,yuan zhi
+pi jing fang yang yin zhen jie cheng e,ai qu di zu,zhu zuo dian,yan ling a,e
+tuo,duo tuo,zhi,yi bei,pi,bi,po bing fu,bu ji lu,liu long chen xing duo lou mo jiang,xiang shu duo,sui
+xian,wen er gui yu gai shan jun qiao xing,jing chun fu,wu bi xia shan sheng zhi,de
+pu,bu dou yuan zhen chu,zhu,shu xian dao nie yun xian pei fei,pei zou,zhe yi dui lun
+yin,an ju chui chen,zhen pi,bi ling tao,yao,dao xian lu,liu sheng xian yin zhu,du yang reng,er xia
+chong yan yin shu,yu,yao di,ti yu long wei wei nie dui,zhui,sui sui,duo,tuo an huang jie sui
+yin gai,ai,qi yan hui,duo ge,rong,ji yun,yuan wu kui,wei,gui ai,e xi tang ji zhang dao ao xi
+yin sa rao lin tui deng jiao,pi sui,zhui sui ao,yu xian,jian,yan fen ni er ji dao
+xi,xie yin zhi hui long xi li,dai,yi,di li li zhui,cui,wei hu,he,que zhi,huo sun juan,jun nan yi
+que,qiao yan qin qian,jie xiong ya ji gu,hu huan zhi,kai,yi,si gou juan,jun,zui ci yong ju chu
+hu za luo yu chou diao sui han wo shuang guan,huan chu,ju za yong ji xi
+chou liu li,chi,gu nan,nuo xue za ji ji yu yu,xu xue na fou se,xi mu wen
+fen pang,fang yun li chi yang ling,lian lei an bao wu,meng dian dang hu wu diao
+xu,nuo,ru,ruan ji mu chen xiao zha,sha,sa,yi ting zhen,shen pei mei ling qi zhou huo,he,suo sha fei
+hong zhan yin ni zhu tun lin ling dong ying,yang wu ling shuang ling xia hong
+yin mai mai yun liu meng bin wu,meng wei kuo yin xi yi ai dan teng
+xian,san yu lu,lou long dai ji pang yang ba,po pi wei feng xi ji mai,li meng,mao,wu
+meng lei li huo,sui,suo ai fei dai long,ling ling ai,yi feng li bao he he he
+bing qing qing,jing jing,liang tian zhen jing cheng qing,jing jing jing,liang dian jing tian fei fei
+kao mi,ma mian mian bao ye tian,mian hui ye,yan ge,ji ding cha qian,jian,kan,han ren di du
+wu ren qin jin xue niu ba yin sa,ta na mo,wa zu da ban yi yao
+tao bei,bai,bi jie hong pao yang bing yin ge,sa,ta tao jie,ji xie,wa an an hen gong
+qia da qiao ting man,men ying,bian sui tiao qiao,shao xuan,juan kong beng ta shang,zhang bing,bi,pi,bei kuo
+ju,qu,qiong la xie,zha,die rou bang eng qiu qiu he,she,mo qiao mu,mou ju,qu jian bian di jian
+wen tao gou ta bei,fu,bu,bai xie pan ge bi,bing kuo tang lou gui,hui qiao,jue xue ji
+jian jiang chan da,ta hu xian qian du wa jian lan wei,hui ren fu mei quan,juan
+ge wei qiao,shao han chang kuo rou yun she wei ge bai,fu tao gou yun,wen gao
+bi wei,xue sui,hui du wa du wei ren fu han wei yun tao jiu jiu xian
+xie xian ji yin za yun shao le peng huang,ying ying yun peng an yin xiang
+hu ye,xie ding qing,kui kui xiang shun han,an xu yi xu e song,rong kui qi,ken hang,gang
+yu wan,kun ban,fen dun,du di dan,dian pan po,pi ling che jing lei he,han,qin,ge qiao e,an e
+wei xie,jia,jie kuo shen yi yi hai,ke dui yu,bian ping lei fu,tao,tiao jia tou hui kui
+jia luo ting cheng ying,jing yun hu han jing,geng tui tui pin,bin lai tui zi zi
+chui ding lai tan,shan han qian ke,kuan cui,zu xuan,jiong,xian qin yi sai ti,di e e yan
+wen,hun kan,yan yong,yu zhuan yan,ya xian xin yi yuan sang dian,tian dian jiang kui,kua lei lao
+piao wai,zhuai man cu yao,qiao hao qiao gu xun yan,qin,han,qian hui chan,zhan,shan ru meng bin xian
+pin lu lan,lin nie quan ye ding qing han xiang shun xu xu wan gu dun,du
+qi ban song hang yu lu ling po jing,geng jie,xie jia ting he,ge ying jiong ke
+yi pin hui tui han ying ying ke ti yong e zhuan yan e nie man
+dian sang hao lei chan,zhan ru pin quan feng biao,diu gua fu xia zhan biao,pao sa,li
+ba,fu tai lie gua,ji xuan shao,xiao ju biao si wei yang yao sou kai sou,sao fan
+liu xi liu,liao piao piao liu biao biao biao liao biao se feng xiu feng yang
+zhan biao sa ju si sou yao liu piao biao biao fei fan fei fei shi,si,yi
+shi can ji ding si tuo zhan,gan sun xiang tun,zhun ren yu juan,yong chi,shi yin fan
+fan sun,can yin tou,zhu yi,si zuo,ze bi jie tao bao ci tie si bao shi,chi duo
+hai ren tian jiao jia,he bing yao tong ci xiang yang juan er yan le xi
+can,sun bo nei e bu jun dou su yu,ye shi,xi yao hun,kun guo shi jian zhui
+bing xian,kan bu ye tan,dan fei zhang wei,nei guan e nuan yun,hun hu huang tie hui
+jian,zhan hou ai,he tang,xing fen wei gu cha song tang bo gao xi kui liu sou
+tao,xian ye wen mo tang man bi yu xiu jin san kui,tui zhuan,xuan shan chi dan
+yi,ye,en ji,qi rao cheng yong tao wei xiang zhan fen hai meng yan mo chan xiang
+luo zan nang shi ding ji tuo tang,xing tun xi ren yu chi fan yin jian
+shi bao si duo yi er rao xiang he le,ge jiao xi bing bo dou e
+yu nei jun guo hun xian guan cha,zha kui gu sou chan ye mo bo liu
+xiu jin man san zhuan nang shou kui,qiu guo,xu xiang fen bo ni bi bo,po tu
+han fei jian an ai fu,bi xian yun,wo xin fen pin xin ma yu feng,ping han,qian
+di tuo,duo,dai zhe,tuo chi xun zhu zhi,shi pei xin,jin ri sa yun wen zhi dan lv
+you bo bao jue,kuai tuo yi qu wen qu jiong po zhao yuan pei,peng zhou ju
+zhu nu ju pi zang,zu jia ling zhen tai,dai,zhai fu yang shi bi tuo tuo si
+liu ma pian tao zhi rong teng dong xun,xuan quan shen jiong er hai bo zhu
+yin luo,jia zhou dan hai liu ju song qin mang lang,liang han tu xuan tui jun
+e cheng xing ai,si,tai lu zhui zhou,dong she pian kun tao lai zong ke qi,ji qi
+yan fei sao yan ge yao wu pian cong pian qian fei huang qian huo yu
+ti quan xia zong kui,jue rou si gua tuo gui,tui sou qian,jian cheng zhi liu peng,bang
+teng xi cao du yan yuan zou,zhu,zhou,qu sao,xiao shan qi zhi,chi shuang lu xi luo zhang
+mo,ma ao,yao can biao,piao cong qu bi zhi yu xu hua bo su xiao lin zhan
+dun liu tuo ceng dian jiao,xiao,ju,qiao tie yan luo zhan jing yi ye tuo pin zhou
+yan long,zang lv teng xiang ji shuang ju xi huan li,chi biao,piao ma yu tuo,duo xun
+chi qu ri bo lv zang shi si fu ju zou zhu tuo nu jia yi
+dai,tai xiao ma yin jiao hua luo hai pian biao li cheng yan xing qin jun
+qi qi ke zhui zong su can pian zhi kui sao wu ao liu qian shan
+biao,piao luo cong chan zhou ji shuang xiang gu wei wei wei,wan yu gan yi ang,kang
+tou,gu jie,jia,xie bao bei ci,zhai ti di ku hai,gai qiao,jiao,xiao hou kua ge tui geng pian
+bi ke,kua qia,ge yu sui lou bo,po xiao bang,pang bo,jue ci,cuo kuan bin mo liao lou
+xiao du zang sui ti bin kuan lu gao gao qiao kao qiao lao sao biao,piao,shan
+kun kun di fang xiu ran mao dan kun bin fa tiao pi zi fa ran
+ti bao bi mao,rou,meng fu,fei er rong,er qu gong xiu kuo,yue ji,jie peng zhua shao suo
+ti li bin zong di,ti peng song zheng quan zong shun jian tuo,chui,duo hu la jiu
+qi lian zhen bin peng ma san man man seng xu lie qian qian nang huan
+kuo,kuai ning bin lie rang,ning dou dou nao hong,xiang xi,he dou han dou dou jiu chang
+yu yu ge,li,e yan fu,li qin,xin gui zong,zeng liu gui,xie shang yu,zhou,ju gui mei ji,qi qi
+ga kui,kuai hun ba po,bo,tuo mei xu yan xiao liang yu tui,chui qi wang liang wei
+gan chi piao bi mo ji xu chou yan zhan yu dao ren jie,ji ba hong,gong
+tuo diao,di ji xu,yu e,hua e,qie,ji sha,suo hang tun mo jie shen ban yuan,wan pi,bi lu,lv
+wen hu lu za,shi fang fen na you pian mo he,ge xia qu,xie han pi ling,lin
+tuo bo,ba qiu ping fu bi ci,ji wei ju,qu,gou diao ba,bo you,chou gun pi,ju nian xing,zheng
+tai bao,pao fu zha ju gu shi dong dai ta jie,qia shu hou xiang,zhen er an
+wei zhao zhu yin lie luo,ge tong ti,yi yi,qi bing,bi wei jiao ku gui,xie,hua,wa,kui xian ge
+hui lao fu kao xiu duo jun ti mian shao zha suo qin yu nei zhe
+gun geng su wu qiu shan,shen pu,bu huan tiao,you,chou li sha sha kao meng cheng li
+zou xi yong shen zi qi zheng,qing xiang nei chun ji diao qie gu zhou dong
+lai fei ni yi kun lu jiu,ai chang jing,qing lun ling zou li meng zong zhi
+nian hu yu di shi shen huan ti hou xing zhu la zong zei,ji bian bian
+huan quan zei,ze wei wei yu chun rou die,qie,zha huang lian yan qiu qiu jian bi
+e yang fu sai,xi gan,jian,xian xia tuo,wei hu shi ruo xuan wen qian,jian hao wu fang,pang
+sao liu ma shi shi guan,kun,gun zi teng ta,die yao e,ge yong qian qi wen ruo
+shen lian ao le hui min ji tiao qu jian shen,sao,can man xi qiu biao ji
+ji zhu jiang xiu,qiu zhuan,tuan,lian yong zhang kang xue bie yu qu xiang bo jiao xun
+su huang zun shan,tuo shan fan gui,jue lin xun miao xi zeng xiang fen guan hou
+kuai zei sao zhan,shan gan gui ying,sheng,meng li chang lei shu ai ru ji xu,yu hu
+shu li lie,la li,lu,luo mie zhen xiang e lu guan li xian yu dao ji you
+tun lu fang ba he ba,bo ping nian lu you zha fu ba,bo bao hou pi
+tai gui,xie jie kao wei er tong zei hou kuai ji jiao xian zha xiang xun
+geng li lian jian li shi tiao gun sha huan jun ji yong qing,zheng ling qi
+zou fei kun chang gu ni nian diao jing shen shi zi fen die bi chang
+ti wen wei sai e qiu fu huang quan jiang bian sao ao qi ta guan
+yao pang jian le biao xue bie man min yong wei xi gui shan lin zun
+hu gan li zhan guan niao,diao,dao,que yi fu li jiu,qiu,zhi bu yan fu diao,zhao ji feng
+ru gan,han,yan shi feng ming bao yuan zhi,chi hu qin fu,gui ban,fen wen jian,qian,zhan shi yu
+fou yao,ao jue,gui jue pi huan zhen bao yan ya zheng fang feng wen ou dai
+ge ru ling mie,bi fu tuo min,wen li bian zhi ge yuan ci qu,gou xiao chi
+dan ju yao,ao gu zhong yu yang yu ya tie,hu yu tian ying dui wu er
+gua ai zhi yan,an,e heng xiao jia lie zhu yang,xiang ti,yi hong luo ru mou ge
+ren jiao,xiao xiu zhou,diao chi luo,ge heng nian e luan jia ji tu huan,juan,guan tuo bu,pu
+wu juan yu bo jun jun bi xi jun ju tu jing ti e e kuang
+hu,gu,he wu shen lai,chi jiao pan lu pi shu fu an,ya zhuo peng,feng qin qian bei
+diao lu que jian ju tu ya yuan qi li ye zhui kong duo kun sheng
+qi jing yi yi jing,qing zi lai dong qi chun,tuan geng ju jue,qu yi zun ji
+shu ying chi miao rou an qiu ti,chi hu ti e jie mao fu,bi chun tu
+yan he yuan pian,bian kun mei hu ying chuan,zhi wu,mu ju dong cang,qiang fang he,hu ying
+yuan xian weng shi he chu tang xia ruo liu ji gu,hu jian,qian sun,xun han ci
+ci yi yao yan ji li tian kou ti ti,si yi tu ma xiao gao tian
+chen ji tuan zhe ao yao,xiao yi ou chi zhi,zhe liu yong lv bi shuang zhuo
+yu wu jue yin ti,tan si jiao yi hua bi ying su huang fan jiao liao
+yan gao jiu xian xian tu mai zun yu,shu ying lu tuan xian xue yi pi
+chu,zhu luo xi,qi yi ji ze yu zhan ye yang pi,bi ning hu mi ying meng,mang
+di yue yu lei bu lu he long shuang yue ying guan,huan,quan qu li luan niao,diao
+jiu ji yuan ming shi ou ya cang bao zhen gu dong lu ya xiao yang
+ling chi qu yuan xue tuo si zhi er gua xiu heng zhou ge luan hong
+wu bo li juan gu,hu e yu xian ti wu que miao an kun bei peng
+qian chun geng yuan su hu he e gu,hu qiu ci mei wu yi yao weng
+liu ji yi jian he yi ying zhe liu liao jiao jiu yu lu huan zhan
+ying hu meng guan shuang lu jin ling jian xian,jian cuo jian jian yan cuo lu,lv
+you cu ji pao,biao,piao cu pao zhu,cu jun,qun zhu jian mi mi yu liu chen jun
+lin ni qi lu jiu jun,qun jing li,si xiang xian,yan jia mi li she zhang lin
+jing qi ling yan cu mai mai he chao fu mian mian fu pao qu qu
+mou fu xian,yan lai qu mian chi feng fu qu mian ma me mo,ma,me hui mi
+zou nun fen huang huang jin guang tian tou hong hua kuang hong shu li nian
+chi,li hei hei yi qian dan xi tun mo mo qian,jian dai chu you,yi dian,zhan,duo yi
+xia yan qu mei yan qing yue,ye li,lai dang,tang,cheng du can yan yan,jian yan dan,tan,zhen,shen an
+zhen,yan dai,zhen can yi,wa mei zhan,dan yan du lu zhi,xian fen fu fu mian,meng,min min,mian,meng yuan
+cu qu chao,zhao wa zhu zhi meng ao bie tuo bi yuan chao tuo ding,zhen mi
+nai ding zi gu gu dong,tong fen tao yuan pi chang gao qi,cao yuan tang teng
+shu shu fen fei wen ba,fei diao tuo zhong qu sheng shi you shi ting wu
+ju jing hun ju,xi yan tu si xi xian yan lei bi yao qiu han wu,hui
+wu hou,ku xie e,he zha xiu weng zha nong nang qi,ji,zi,zhai,jian zhai ji zi,ji ji ji
+qi,ji ji chi chen chen he ya yin,yan xie bao ze xie,shi chai,zi chi yan ju,zha
+tiao ling ling chu,chi quan xie ken,qian,yin,kun nie jiu yao chuo yun yu,wu chu yi,qi ni
+ze,ce,zha zou,chuo qu yun yan ou,yu e wo yi ci,cuo zou dian chu jin ya,e chi
+chen he yin ju ling bao tiao zi ken,yin yu chuo qu wo long,mang pang gong,wo
+pang,long yan long long gong kan,ke da ling da long gong kan gui,qiu,jun qiu bie gui,jun,qiu
+yue chui he jue xie yu - - - - - - - - - -
+- - - - - - - - - - - - - - - -
+- - - shan - - - - - - - - - gang ta,da mai
+- - - - ge dan - - - - - - - - - -
+- - - - - - - - - - - ao tian ni - -
+- - - - - - - - - - - - - - - -
+`
@@ -0,0 +1,61 @@
+package search_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/aosen/search"
+	"github.com/aosen/search/pinyin"
+)
+
+// 启用拼音搜索和输入提示的引擎
+func newPinyinEngine(t *testing.T) *search.Engine {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		Segmenter:         newTestSegmenter(t),
+		Pinyin:            pinyin.NewPinyinTable(),
+		EnableSuggestions: true,
+	})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "北京手机"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "北京价格"})
+	engine.IndexDocument(3, search.DocumentIndexData{Content: "中国手机"})
+	engine.FlushIndex()
+	return engine
+}
+
+func TestPinyinMixedQuery(t *testing.T) {
+	engine := newPinyinEngine(t)
+	for text, want := range map[string]string{
+		"bj":        "[1 2]",
+		"北京 shouji": "[1]",
+		"北京 bj":     "[1 2]",
+		"中国 sj":     "[3]",
+	} {
+		docIds := searchDocIds(t, engine, search.SearchRequest{Text: text})
+		sort.Slice(docIds, func(i, j int) bool { return docIds[i] < docIds[j] })
+		if fmt.Sprint(docIds) != want {
+			t.Errorf("%s: 命中%v，应为%s", text, docIds, want)
+		}
+	}
+}
+
+func TestPinyinExcludedFromSuggestionsAndFuzzy(t *testing.T) {
+	engine := newPinyinEngine(t)
+	if suggestions, err := engine.Suggest("b", 10); err != nil || len(suggestions) != 0 {
+		t.Errorf("拼音不应参与输入提示: %v %v", suggestions, err)
+	}
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Text: "shouj", Fuzziness: 1}); len(docIds) != 0 {
+		t.Errorf("拼音不应参与模糊匹配，命中%v", docIds)
+	}
+
+	// 文档中本来就有的英文关键词照常参与输入提示
+	engine.IndexDocument(4, search.DocumentIndexData{Content: "beijing价格"})
+	engine.FlushIndex()
+	suggestions, err := engine.Suggest("b", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(suggestions) != "[{beijing 1}]" {
+		t.Errorf("输入提示为%v", suggestions)
+	}
+}
@@ -1,19 +1,24 @@
 package search
 
 //存储器
+//返回的错误应当包装ErrStorage，方便调用者判断
 type SearchPipline interface {
 	//初始化存储器, shard为初始化的集合编号
-	Init()
+	Init() error
 	//获取存储集合数量, 集合数量可以提高并行计算效率
 	GetStorageShards() int
 	//连接数据库
-	Conn(shard int)
+	Conn(shard int) error
 	//关闭数据库连接
-	Close(shard int)
+	Close(shard int) error
 	//将数据从shard DB恢复到内存
 	Recover(shard int, internalIndexDocument func(docId uint64, data DocumentIndexData)) error
 	//存储索引
-	Set(shard int, key, value []byte)
+	Set(shard int, key, value []byte) error
+	//批量存储索引，keys和values一一对应，失败时整批视为失败
+	SetBatch(shard int, keys, values [][]byte) error
 	//从DB删除索引
-	Delete(shard int, key []byte)
+	Delete(shard int, key []byte) error
+	//读取key对应的value，key不存在时返回nil, nil
+	Get(shard int, key []byte) ([]byte, error)
 }
@@ -6,8 +6,8 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/gob"
+	"fmt"
 	"io"
-	"log"
 	"os"
 	"strconv"
 
@@ -39,37 +39,42 @@ func (self *KVPipline) GetStorageShards() int {
 	return self.shardnum
 }
 
-func (self *KVPipline) Init() {
+func (self *KVPipline) Init() error {
 	err := os.MkdirAll(self.storageFolder, 0700)
 	if err != nil {
-		log.Fatal("无法创建目录", self.storageFolder)
+		return fmt.Errorf("%w: 无法创建目录 %s: %v", search.ErrStorage, self.storageFolder, err)
 	}
 
-	// 打开或者创建数据库
+	// 打开或者创建数据库，失败时关闭已经打开的数据库
 	self.dbs = make([]*kv.DB, self.shardnum)
 	for shard := 0; shard < self.shardnum; shard++ {
-		dbPath := self.storageFolder + "/" + "db." + strconv.Itoa(shard)
-		db, err := OpenOrCreateKv(dbPath, &kv.Options{})
-		if db == nil || err != nil {
-			log.Fatal("无法打开数据库", dbPath, ": ", err, db)
+		if err := self.Conn(shard); err != nil {
+			for opened := 0; opened < shard; opened++ {
+				self.dbs[opened].Close()
+			}
+			return err
 		}
-		self.dbs[shard] = db
 	}
+	return nil
 }
 
 //连接数据库
-func (self *KVPipline) Conn(shard int) {
+func (self *KVPipline) Conn(shard int) error {
 	dbPath := self.storageFolder + "/" + "db." + strconv.Itoa(shard)
 	db, err := OpenOrCreateKv(dbPath, &kv.Options{})
 	if db == nil || err != nil {
-		log.Fatal("无法打开数据库", dbPath, ": ", err, db)
+		return fmt.Errorf("%w: 无法打开数据库 %s: %v", search.ErrStorage, dbPath, err)
 	}
 	self.dbs[shard] = db
+	return nil
 }
 
 //关闭数据连接
-func (self *KVPipline) Close(shard int) {
-	self.dbs[shard].Close()
+func (self *KVPipline) Close(shard int) error {
+	if err := self.dbs[shard].Close(); err != nil {
+		return fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
+	return nil
 }
 
 //从shard 恢复数据
@@ -105,12 +110,45 @@ func (self *KVPipline) Recover(shard int, internalIndexDocument func(docId uint6
 }
 
 //将key－value存储到哪个集合中
-func (self *KVPipline) Set(shard int, key, value []byte) {
-	self.dbs[shard].Set(key, value)
+func (self *KVPipline) Set(shard int, key, value []byte) error {
+	if err := self.dbs[shard].Set(key, value); err != nil {
+		return fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
+	return nil
 }
 
-func (self *KVPipline) Delete(shard int, key []byte) {
-	self.dbs[shard].Delete(key)
+//在一个事务中批量存储key－value
+func (self *KVPipline) SetBatch(shard int, keys, values [][]byte) error {
+	db := self.dbs[shard]
+	if err := db.BeginTransaction(); err != nil {
+		return fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
+	for i := range keys {
+		if err := db.Set(keys[i], values[i]); err != nil {
+			db.Rollback()
+			return fmt.Errorf("%w: %v", search.ErrStorage, err)
+		}
+	}
+	if err := db.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
+	return nil
+}
+
+func (self *KVPipline) Delete(shard int, key []byte) error {
+	if err := self.dbs[shard].Delete(key); err != nil {
+		return fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
+	return nil
+}
+
+//读取key对应的value，key不存在时返回nil
+func (self *KVPipline) Get(shard int, key []byte) ([]byte, error) {
+	value, err := self.dbs[shard].Get(nil, key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
+	return value, nil
 }
 
 // 打开或者创建KV数据库
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/gob"
+	"fmt"
 	"strconv"
 
 	"github.com/aosen/search"
@@ -39,20 +40,31 @@ func InitMongo(db string, shardnum int, url string, collectionPrefix string) *Mo
 	}
 }
 
-func (self *MongoPipline) Init() {
+func (self *MongoPipline) Init() error {
 	self.sessions = make([]*mgo.Session, self.shardnum)
+	session, err := self.dial()
+	if err != nil {
+		return err
+	}
+	for shard := 0; shard < self.shardnum; shard++ {
+		self.sessions[shard] = session
+	}
+	return nil
+}
+
+//连接mongodb并检查连接是否可用
+func (self *MongoPipline) dial() (*mgo.Session, error) {
 	session, err := mgo.Dial(self.url)
 	if err != nil {
-		panic("open mongodb file:" + err.Error())
+		return nil, fmt.Errorf("%w: open mongodb: %v", search.ErrStorage, err)
 	}
 	if err = session.Ping(); err != nil {
-		panic("MongoDB execute ping error:" + err.Error())
+		session.Close()
+		return nil, fmt.Errorf("%w: MongoDB execute ping error: %v", search.ErrStorage, err)
 	}
 	// Optional. Switch the session to a monotonic behavior.
 	session.SetMode(mgo.Monotonic, true)
-	for shard := 0; shard < self.shardnum; shard++ {
-		self.sessions[shard] = session
-	}
+	return session, nil
 }
 
 func (self *MongoPipline) GetStorageShards() int {
@@ -60,22 +72,19 @@ func (self *MongoPipline) GetStorageShards() int {
 }
 
 //连接数据库
-func (self *MongoPipline) Conn(shard int) {
-	session, err := mgo.Dial(self.url)
+func (self *MongoPipline) Conn(shard int) error {
+	session, err := self.dial()
 	if err != nil {
-		panic("open mongodb file:" + err.Error())
-	}
-	if err = session.Ping(); err != nil {
-		panic("MongoDB execute ping error:" + err.Error())
+		return err
 	}
-	// Optional. Switch the session to a monotonic behavior.
-	session.SetMode(mgo.Monotonic, true)
 	self.sessions[shard] = session
+	return nil
 }
 
 //关闭数据库连接
-func (self *MongoPipline) Close(shard int) {
+func (self *MongoPipline) Close(shard int) error {
 	self.sessions[shard].Close()
+	return nil
 }
 
 func (self *MongoPipline) Recover(shard int, internalIndexDocument func(docId uint64, data search.DocumentIndexData)) error {
@@ -102,20 +111,53 @@ func (self *MongoPipline) Recover(shard int, internalIndexDocument func(docId ui
 	return nil
 }
 
-//将key－value存储到哪个集合中
-func (self *MongoPipline) Set(shard int, key, value []byte) {
+//将key－value存储到哪个集合中，key已存在时覆盖原有的value
+func (self *MongoPipline) Set(shard int, key, value []byte) error {
 	c := self.sessions[shard].DB(self.mongoDBName).C(self.collectionPrefix + strconv.Itoa(shard))
-	err := c.Insert(&mgokeyvalue{
-		Id_:   bson.NewObjectId(),
-		Key:   key,
-		Value: value,
+	_, err := c.Upsert(bson.M{"key": key}, bson.M{
+		"$set":         bson.M{"Value": value},
+		"$setOnInsert": bson.M{"_id": bson.NewObjectId()},
 	})
 	if err != nil {
-		panic("store kv err: " + err.Error())
+		return fmt.Errorf("%w: store kv err: %v", search.ErrStorage, err)
 	}
+	return nil
 }
 
-func (self *MongoPipline) Delete(shard int, key []byte) {
+//用一次bulk操作批量存储key－value
+func (self *MongoPipline) SetBatch(shard int, keys, values [][]byte) error {
 	c := self.sessions[shard].DB(self.mongoDBName).C(self.collectionPrefix + strconv.Itoa(shard))
-	c.Remove(bson.M{"key": key})
+	bulk := c.Bulk()
+	bulk.Unordered()
+	for i := range keys {
+		bulk.Upsert(bson.M{"key": keys[i]}, bson.M{
+			"$set":         bson.M{"Value": values[i]},
+			"$setOnInsert": bson.M{"_id": bson.NewObjectId()},
+		})
+	}
+	if _, err := bulk.Run(); err != nil {
+		return fmt.Errorf("%w: store kv batch err: %v", search.ErrStorage, err)
+	}
+	return nil
+}
+
+//读取key对应的value，key不存在时返回nil
+func (self *MongoPipline) Get(shard int, key []byte) ([]byte, error) {
+	c := self.sessions[shard].DB(self.mongoDBName).C(self.collectionPrefix + strconv.Itoa(shard))
+	var kv mgokeyvalue
+	if err := c.Find(bson.M{"key": key}).One(&kv); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: get kv err: %v", search.ErrStorage, err)
+	}
+	return kv.Value, nil
+}
+
+func (self *MongoPipline) Delete(shard int, key []byte) error {
+	c := self.sessions[shard].DB(self.mongoDBName).C(self.collectionPrefix + strconv.Itoa(shard))
+	if err := c.Remove(bson.M{"key": key}); err != nil && err != mgo.ErrNotFound {
+		return fmt.Errorf("%w: delete kv err: %v", search.ErrStorage, err)
+	}
+	return nil
 }
@@ -45,9 +45,11 @@ var CreateTable string = `CREATE TABLE %s (
 ;`
 
 //如果没有表就创建表
-func (self *MysqlPipline) Init() {
+func (self *MysqlPipline) Init() error {
 	orm.RegisterDriver("mysql", orm.DR_MySQL)
-	orm.RegisterDataBase("search", "mysql", self.dbinfo)
+	if err := orm.RegisterDataBase("search", "mysql", self.dbinfo); err != nil {
+		return fmt.Errorf("%w: %v", search.ErrStorage, err)
+	}
 	orm.RegisterModel(new(mysqlkeyvalue))
 	//create table
 	//orm.RunSyncdb("default", false, true)
@@ -58,6 +60,7 @@ func (self *MysqlPipline) Init() {
 	for i := 0; i < self.shardnum; i++ {
 		o.Raw(fmt.Sprintf(CreateTable, self.tablePrefix+strconv.Itoa(i))).Exec()
 	}
+	return nil
 }
 
 func (self *MysqlPipline) GetStorageShards() int {
@@ -65,11 +68,13 @@ func (self *MysqlPipline) GetStorageShards() int {
 }
 
 //连接数据库
-func (self *MysqlPipline) Conn(shard int) {
+func (self *MysqlPipline) Conn(shard int) error {
+	return nil
 }
 
 //关闭数据库连接
-func (self *MysqlPipline) Close(shard int) {
+func (self *MysqlPipline) Close(shard int) error {
+	return nil
 }
 
 //数据恢复
@@ -78,9 +83,21 @@ func (self *MysqlPipline) Recover(shard int, internalIndexDocument func(docId ui
 }
 
 //数据存储
-func (self *MysqlPipline) Set(shard int, key, value []byte) {
+func (self *MysqlPipline) Set(shard int, key, value []byte) error {
+	return nil
+}
+
+//批量数据存储
+func (self *MysqlPipline) SetBatch(shard int, keys, values [][]byte) error {
+	return nil
 }
 
 //数据删除
-func (self *MysqlPipline) Delete(shard int, key []byte) {
+func (self *MysqlPipline) Delete(shard int, key []byte) error {
+	return nil
+}
+
+//数据读取
+func (self *MysqlPipline) Get(shard int, key []byte) ([]byte, error) {
+	return nil, nil
 }
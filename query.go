@@ -0,0 +1,457 @@
+package search
+
+//查询语法树以及查询字符串的解析
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 查询语法树的节点类型
+const (
+	// 单个搜索键
+	TermQuery = iota
+
+	// 同时满足全部子查询，NotQuery类型的子节点表示从结果中排除
+	AndQuery
+
+	// 满足任意一个子查询
+	OrQuery
+
+	// 排除子查询命中的文档，只有作为AndQuery的子节点时才有意义
+	NotQuery
+
+	// 短语查询，子节点为按顺序排列的搜索键，要求它们在文档中依次紧邻出现，
+	// 或者间隔不超过Slop个字节。只有LocationsIndex类型的索引才能检查位置，
+	// 其他类型的索引上短语查询等同于AndQuery
+	PhraseQuery
+
+	// 同义词组，满足任意一个子查询即可。与OrQuery不同，打分时只计得分最高的一个子查询，
+	// 文档同时包含多个同义词时BM25不会成倍增加
+	SynonymQuery
+)
+
+// 查询语法树的一个节点
+type Query struct {
+	// 节点类型，见上面的常数
+	Type int
+
+	// 搜索键的UTF-8文本，仅当Type == TermQuery时有效
+	Term string
+
+	// 子查询，Type == NotQuery时只有一个子节点
+	Children []*Query
+
+	// 短语查询允许的关键词间隔，单位为字节，0表示关键词必须紧邻
+	// 间隔为相邻两个关键词之间跳过的字节数之和，关键词的顺序不能颠倒
+	Slop int
+
+	// 搜索键BM25得分的权重，仅当Type == TermQuery时有效，为0时等于1
+	// 模糊匹配得到的搜索键权重小于1
+	Boost float32
+}
+
+func NewTermQuery(term string) *Query {
+	return &Query{Type: TermQuery, Term: term}
+}
+
+func NewAndQuery(children ...*Query) *Query {
+	return &Query{Type: AndQuery, Children: children}
+}
+
+func NewOrQuery(children ...*Query) *Query {
+	return &Query{Type: OrQuery, Children: children}
+}
+
+func NewNotQuery(child *Query) *Query {
+	return &Query{Type: NotQuery, Children: []*Query{child}}
+}
+
+// 生成短语查询，terms按在文档中出现的顺序排列
+func NewPhraseQuery(slop int, terms ...string) *Query {
+	children := make([]*Query, len(terms))
+	for i, term := range terms {
+		children[i] = NewTermQuery(term)
+	}
+	return &Query{Type: PhraseQuery, Children: children, Slop: slop}
+}
+
+// 生成同义词组，第一个子查询通常是原来的搜索键
+func NewSynonymQuery(children ...*Query) *Query {
+	return &Query{Type: SynonymQuery, Children: children}
+}
+
+// 返回参与打分的搜索键，即不在NOT之下的全部搜索键，按出现顺序排列并去重
+// 索引器返回的TokenSnippetLocations和TokenLocations与此一一对应
+func (query *Query) Terms() (terms []string) {
+	seen := make(map[string]bool)
+	query.walkTerms(func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	})
+	return
+}
+
+// 返回与Terms()一一对应的权重，同一搜索键出现多次时取最大的权重
+func (query *Query) TermBoosts() []float32 {
+	indices := make(map[string]int)
+	var boosts []float32
+	query.walkTermQueries(func(term *Query) {
+		boost := term.Boost
+		if boost == 0 {
+			boost = 1
+		}
+		if i, found := indices[term.Term]; !found {
+			indices[term.Term] = len(boosts)
+			boosts = append(boosts, boost)
+		} else if boost > boosts[i] {
+			boosts[i] = boost
+		}
+	})
+	return boosts
+}
+
+func (query *Query) walkTerms(visit func(term string)) {
+	query.walkTermQueries(func(term *Query) { visit(term.Term) })
+}
+
+// 按出现顺序访问不在NOT之下的全部TermQuery节点
+func (query *Query) walkTermQueries(visit func(term *Query)) {
+	switch query.Type {
+	case TermQuery:
+		visit(query)
+	case AndQuery, OrQuery, PhraseQuery, SynonymQuery:
+		for _, child := range query.Children {
+			child.walkTermQueries(visit)
+		}
+	}
+}
+
+// 返回查询中的同义词组，用于打分
+// 每个同义词组对应一个[][]int，其中每一项为一个子查询包含的搜索键在Terms()中的下标
+func (query *Query) SynonymGroups() (groups [][][]int) {
+	indices := make(map[string]int)
+	for i, term := range query.Terms() {
+		indices[term] = i
+	}
+	var walk func(query *Query)
+	walk = func(query *Query) {
+		switch query.Type {
+		case SynonymQuery:
+			group := make([][]int, len(query.Children))
+			for i, child := range query.Children {
+				child.walkTerms(func(term string) {
+					group[i] = append(group[i], indices[term])
+				})
+			}
+			groups = append(groups, group)
+		case AndQuery, OrQuery:
+			for _, child := range query.Children {
+				walk(child)
+			}
+		}
+	}
+	walk(query)
+	return
+}
+
+// 输出查询的规范形式，主要用于调试
+func (query *Query) String() string {
+	switch query.Type {
+	case TermQuery:
+		if query.Boost != 0 && query.Boost != 1 {
+			return fmt.Sprintf("%s^%g", query.Term, query.Boost)
+		}
+		return query.Term
+	case NotQuery:
+		return "-" + query.Children[0].String()
+	}
+	parts := make([]string, len(query.Children))
+	for i, child := range query.Children {
+		parts[i] = child.String()
+	}
+	if query.Type == PhraseQuery {
+		if query.Slop > 0 {
+			return fmt.Sprintf("\"%s\"~%d", strings.Join(parts, " "), query.Slop)
+		}
+		return "\"" + strings.Join(parts, " ") + "\""
+	}
+	if query.Type == OrQuery {
+		return "(" + strings.Join(parts, " OR ") + ")"
+	}
+	if query.Type == SynonymQuery {
+		return "(" + strings.Join(parts, " | ") + ")"
+	}
+	return "(" + strings.Join(parts, " AND ") + ")"
+}
+
+// 解析查询字符串，生成查询语法树
+//
+// 语法：
+//
+//	手机 华为		空白分隔的搜索键之间为AND关系，也可以显式写作 手机 AND 华为
+//	手机 OR 电话		满足任意一个
+//	NOT 二手, -二手		排除包含该搜索键的文档
+//	(手机 OR 电话)		用括号分组
+//	"华为 手机"		短语查询，要求关键词按顺序紧邻出现
+//	"华为 手机"~4		短语查询，关键词之间的间隔不超过4个字节
+//
+// 优先级从高到低为NOT、AND、OR，关键字AND、OR、NOT必须大写。
+// 例如 "(手机 OR 电话) AND 华为 -二手"。排除条件只能与其他条件AND在一起，
+// 整个查询只有排除条件、OR的一项是排除条件或者排除条件嵌套时返回错误。
+// 解析出的搜索键是原始文本，引擎搜索时还会对它们做分词。
+// 查询不符合语法时返回包装了ErrQuerySyntax的错误
+func ParseQuery(text string) (*Query, error) {
+	parser := queryParser{tokens: lexQuery(text)}
+	if len(parser.tokens) == 0 {
+		return nil, fmt.Errorf("%w: 查询为空", ErrQuerySyntax)
+	}
+	query, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.position < len(parser.tokens) {
+		return nil, fmt.Errorf("%w: 多余的 \"%s\"", ErrQuerySyntax, parser.tokens[parser.position].text)
+	}
+	if err := validateQuery(query); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// 检查排除条件的位置，不符合时返回包装了ErrQuerySyntax的错误
+// 排除条件只能在AND中与至少一个其他条件一起出现，索引器用它从其他条件的结果中
+// 减去文档。单独的排除条件、OR中的排除条件和排除条件的排除条件都无法这样求值
+func validateQuery(query *Query) error {
+	if isExclusion(query) {
+		return fmt.Errorf("%w: 查询只有排除条件", ErrQuerySyntax)
+	}
+	return checkExclusions(query)
+}
+
+func checkExclusions(query *Query) error {
+	for _, child := range query.Children {
+		switch query.Type {
+		case OrQuery, SynonymQuery:
+			if isExclusion(child) {
+				return fmt.Errorf("%w: OR的一项不能是排除条件", ErrQuerySyntax)
+			}
+		case NotQuery:
+			if isExclusion(child) {
+				return fmt.Errorf("%w: 排除条件不能嵌套", ErrQuerySyntax)
+			}
+		}
+		if err := checkExclusions(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 查询字符串的词法单元类型
+const (
+	queryWord = iota
+	queryAnd
+	queryOr
+	queryNot
+	queryLeftParen
+	queryRightParen
+	queryPhrase
+)
+
+type queryToken struct {
+	kind int
+	text string
+	slop int
+}
+
+// 将查询字符串切分为词法单元
+func lexQuery(text string) (tokens []queryToken) {
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: queryLeftParen, text: "("})
+			i += size
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: queryRightParen, text: ")"})
+			i += size
+		case r == '"':
+			// 引号中的文本为短语，缺少右引号时到查询末尾为止
+			i += size
+			end := strings.IndexByte(text[i:], '"')
+			if end < 0 {
+				end = len(text) - i
+			}
+			token := queryToken{kind: queryPhrase, text: text[i : i+end]}
+			i += end
+			if i < len(text) {
+				i++
+			}
+
+			// 短语后面紧跟的~N为允许的间隔
+			if i < len(text) && text[i] == '~' {
+				j := i + 1
+				for j < len(text) && text[j] >= '0' && text[j] <= '9' {
+					token.slop = token.slop*10 + int(text[j]-'0')
+					j++
+				}
+				i = j
+			}
+			tokens = append(tokens, token)
+		case r == '-' && i+size < len(text) && !startsWithSpace(text[i+size:]):
+			// 紧跟在词、括号或者短语前面的减号表示排除
+			tokens = append(tokens, queryToken{kind: queryNot, text: "-"})
+			i += size
+		default:
+			start := i
+			for i < len(text) && !isQuerySeparator(text[i:]) {
+				_, size := utf8.DecodeRuneInString(text[i:])
+				i += size
+			}
+			word := text[start:i]
+			switch word {
+			case "AND":
+				tokens = append(tokens, queryToken{kind: queryAnd, text: word})
+			case "OR":
+				tokens = append(tokens, queryToken{kind: queryOr, text: word})
+			case "NOT":
+				tokens = append(tokens, queryToken{kind: queryNot, text: word})
+			default:
+				tokens = append(tokens, queryToken{kind: queryWord, text: word})
+			}
+		}
+	}
+	return
+}
+
+// 判断text的第一个字符是否结束一个搜索键
+func isQuerySeparator(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.IsSpace(r) || r == '(' || r == ')' || r == '"'
+}
+
+func startsWithSpace(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.IsSpace(r)
+}
+
+// 递归下降解析器
+type queryParser struct {
+	tokens   []queryToken
+	position int
+}
+
+func (parser *queryParser) peek() (queryToken, bool) {
+	if parser.position >= len(parser.tokens) {
+		return queryToken{}, false
+	}
+	return parser.tokens[parser.position], true
+}
+
+// or := and ("OR" and)*
+func (parser *queryParser) parseOr() (*Query, error) {
+	first, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []*Query{first}
+	for {
+		token, ok := parser.peek()
+		if !ok || token.kind != queryOr {
+			break
+		}
+		parser.position++
+		next, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return first, nil
+	}
+	return NewOrQuery(children...), nil
+}
+
+// 查询是否只有排除条件，即NOT或者只由NOT组成的AND
+func isExclusion(query *Query) bool {
+	switch query.Type {
+	case NotQuery:
+		return true
+	case AndQuery:
+		for _, child := range query.Children {
+			if !isExclusion(child) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// and := unary (["AND"] unary)*
+func (parser *queryParser) parseAnd() (*Query, error) {
+	first, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	children := []*Query{first}
+	for {
+		token, ok := parser.peek()
+		if !ok || token.kind == queryOr || token.kind == queryRightParen {
+			break
+		}
+		if token.kind == queryAnd {
+			parser.position++
+		}
+		next, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return first, nil
+	}
+	return NewAndQuery(children...), nil
+}
+
+// unary := ("NOT" | "-") unary | "(" or ")" | phrase | word
+func (parser *queryParser) parseUnary() (*Query, error) {
+	token, ok := parser.peek()
+	if !ok {
+		return nil, fmt.Errorf("%w: 查询不完整", ErrQuerySyntax)
+	}
+	parser.position++
+	switch token.kind {
+	case queryNot:
+		child, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return NewNotQuery(child), nil
+	case queryLeftParen:
+		query, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if next, ok := parser.peek(); !ok || next.kind != queryRightParen {
+			return nil, fmt.Errorf("%w: 缺少右括号", ErrQuerySyntax)
+		}
+		parser.position++
+		return query, nil
+	case queryWord:
+		return NewTermQuery(token.text), nil
+	case queryPhrase:
+		// 短语的原始文本在引擎搜索时分词
+		return NewPhraseQuery(token.slop, token.text), nil
+	}
+	return nil, fmt.Errorf("%w: 意外的 \"%s\"", ErrQuerySyntax, token.text)
+}
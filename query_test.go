@@ -0,0 +1,136 @@
+package search_test
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+func TestParseQuery(t *testing.T) {
+	for text, want := range map[string]string{
+		"(手机 OR 电话) AND 华为 -二手":  "((手机 OR 电话) AND 华为 AND -二手)",
+		"手机 华为":                  "(手机 AND 华为)",
+		"a OR b c":               "(a OR (b AND c))",
+		"a -b NOT c e-mail OR d": "((a AND -b AND -c AND e-mail) OR d)",
+		`"华为 手机"~4 -"二手 手机"`:     `("华为 手机"~4 AND -"二手 手机")`,
+		`a OR (b -c)`:            "(a OR (b AND -c))",
+		"(((a)))":                "a",
+		`"缺少右引号`:                 `"缺少右引号"`,
+		"a - b":                  "(a AND - AND b)",
+		"a and b":                "(a AND and AND b)",
+		"a (-b -c)":              "(a AND (-b AND -c))",
+	} {
+		query, err := search.ParseQuery(text)
+		if err != nil {
+			t.Errorf("%s: %v", text, err)
+			continue
+		}
+		if query.String() != want {
+			t.Errorf("%s: 解析为%s，应为%s", text, query.String(), want)
+		}
+	}
+}
+
+func TestParseQuerySyntaxError(t *testing.T) {
+	for _, text := range []string{
+		"", "   ", "(a", "a OR", "a )", "NOT", "a AND", "()",
+		"-a OR -b", "a (-b OR NOT c)", "(-a -b) OR -c",
+		"手机 OR -华为", "-华为", "NOT 华为", "-a -b", "(-a) OR b", "a -(-b)", "a NOT -b",
+	} {
+		if _, err := search.ParseQuery(text); !errors.Is(err, search.ErrQuerySyntax) {
+			t.Errorf("%q: 应返回ErrQuerySyntax，实际为%v", text, err)
+		}
+	}
+}
+
+func TestSearchQueryExclusions(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{Segmenter: newTestSegmenter(t)})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "华为手机"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "二手华为手机"})
+	engine.IndexDocument(3, search.DocumentIndexData{Content: "华为电话"})
+	engine.IndexDocument(4, search.DocumentIndexData{Content: "中国电话"})
+	engine.FlushIndex()
+
+	// 嵌套在AND中的排除条件同样从结果中减去
+	query, err := search.ParseQuery("华为 (-二手 -电话)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Query: query}); fmt.Sprint(docIds) != "[1]" {
+		t.Errorf("命中%v，应为文档1", docIds)
+	}
+
+	// 直接构造的查询与ParseQuery的规则相同
+	for _, query := range []*search.Query{
+		search.NewNotQuery(search.NewTermQuery("华为")),
+		search.NewOrQuery(search.NewTermQuery("手机"), search.NewNotQuery(search.NewTermQuery("华为"))),
+		search.NewAndQuery(search.NewTermQuery("手机"),
+			search.NewNotQuery(search.NewNotQuery(search.NewTermQuery("华为")))),
+	} {
+		if _, err := engine.Search(search.SearchRequest{Query: query}); !errors.Is(err, search.ErrQuerySyntax) {
+			t.Errorf("%s: 应返回ErrQuerySyntax，实际为%v", query, err)
+		}
+	}
+}
+
+func TestPhraseQueryOrderAndSlop(t *testing.T) {
+	documents := map[uint64][]search.TokenData{
+		1: {{Text: "华为", Locations: []int{0}}, {Text: "手机", Locations: []int{6}}},
+		2: {{Text: "手机", Locations: []int{0}}, {Text: "华为", Locations: []int{6}}},
+		3: {{Text: "华为", Locations: []int{0}}, {Text: "手机", Locations: []int{10}}},
+		4: {{Text: "华为", Locations: []int{0}}, {Text: "手机", Locations: []int{11}}},
+		5: {{Text: "手机", Locations: []int{0, 20}}, {Text: "华为", Locations: []int{6}}},
+	}
+	phraseHits := func(engine *search.Engine, slop int) string {
+		docIds := searchDocIds(t, engine, search.SearchRequest{
+			Tokens: []string{"华为", "手机"}, Phrase: true, Slop: slop})
+		sort.Slice(docIds, func(i, j int) bool { return docIds[i] < docIds[j] })
+		return fmt.Sprint(docIds)
+	}
+
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2})
+	for docId, tokens := range documents {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: tokens})
+	}
+	engine.FlushIndex()
+	for slop, want := range map[int]string{
+		0:   "[1]",
+		4:   "[1 3]",
+		5:   "[1 3 4]",
+		8:   "[1 3 4 5]",
+		100: "[1 3 4 5]",
+	} {
+		if got := phraseHits(engine, slop); got != want {
+			t.Errorf("Slop为%d时命中%s，应为%s", slop, got, want)
+		}
+	}
+
+	// 没有位置信息时短语查询退化为AND查询
+	engine = newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, IndexerInitOptions: &search.IndexerInitOptions{IndexType: search.FrequenciesIndex}})
+	for docId, tokens := range documents {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: tokens})
+	}
+	engine.FlushIndex()
+	if got := phraseHits(engine, 0); got != "[1 2 3 4 5]" {
+		t.Errorf("FrequenciesIndex命中%s", got)
+	}
+}
+
+func TestQueryTermBoost(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2})
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a", "b")})
+	engine.IndexDocument(2, search.DocumentIndexData{Tokens: testTokens("b", "c")})
+	engine.FlushIndex()
+
+	scores := searchScores(t, engine, search.SearchRequest{Query: search.NewTermQuery("a")})
+	boosted := search.NewTermQuery("a")
+	boosted.Boost = 2
+	boostedScores := searchScores(t, engine, search.SearchRequest{Query: boosted})
+	if scores[1] == 0 || boostedScores[1] != 2*scores[1] {
+		t.Errorf("权重为2时得分为%v，不加权时为%v", boostedScores[1], scores[1])
+	}
+}
@@ -2,14 +2,19 @@ package search
 
 //排序器基类
 
+import "context"
+
 //排序起接口
 type SearchRanker interface {
-	//排序起初始化
-	Init()
+	//排序起初始化，重复初始化时返回ErrAlreadyInitialized
+	Init() error
 	// 给某个文档添加评分字段
 	AddScoringFields(docId uint64, fields interface{})
 	// 删除某个文档的评分字段
 	RemoveScoringFields(docId uint64)
 	// 给文档评分并排序
-	Rank(docs []IndexedDocument, options RankOptions) (outputDocs ScoredDocuments)
+	// numDocs为评分后保留下来的文档总数，即按OutputOffset和MaxOutputs截取之前的数目
+	// options.After不为nil时只输出排在其后的文档，但numDocs仍然包括其余的文档
+	// ctx被取消时应尽快放弃排序，此时返回值会被丢弃
+	Rank(ctx context.Context, docs []IndexedDocument, options RankOptions) (outputDocs ScoredDocuments, numDocs int)
 }
@@ -8,7 +8,8 @@ Desc: 采用悟空排序器，源码参考：https://github.com/aosen/wukong
 package ranker
 
 import (
-	"log"
+	"container/heap"
+	"context"
 	"sort"
 	"sync"
 
@@ -16,6 +17,9 @@ import (
 	"github.com/aosen/search/utils"
 )
 
+// 评分时每处理这么多文档检查一次ctx是否已被取消
+const numDocsPerContextCheck = 1024
+
 type WuKongRanker struct {
 	lock struct {
 		sync.RWMutex
@@ -29,19 +33,22 @@ func NewWuKongRanker() *WuKongRanker {
 }
 
 //排序起初始化
-func (self *WuKongRanker) Init() {
+//排序器只能初始化一次，重复初始化时返回search.ErrAlreadyInitialized
+//尚未初始化的排序器忽略评分字段，排序时返回空结果
+func (self *WuKongRanker) Init() error {
 	if self.initialized == true {
-		log.Fatal("排序器不能初始化两次")
+		return search.ErrAlreadyInitialized
 	}
 	self.initialized = true
 
 	self.lock.fields = make(map[uint64]interface{})
+	return nil
 }
 
 // 给某个文档添加评分字段
 func (self *WuKongRanker) AddScoringFields(docId uint64, fields interface{}) {
 	if self.initialized == false {
-		log.Fatal("排序器尚未初始化")
+		return
 	}
 
 	self.lock.Lock()
@@ -52,7 +59,7 @@ func (self *WuKongRanker) AddScoringFields(docId uint64, fields interface{}) {
 // 删除某个文档的评分字段
 func (self *WuKongRanker) RemoveScoringFields(docId uint64) {
 	if self.initialized == false {
-		log.Fatal("排序器尚未初始化")
+		return
 	}
 
 	self.lock.Lock()
@@ -61,35 +68,75 @@ func (self *WuKongRanker) RemoveScoringFields(docId uint64) {
 }
 
 // 给文档评分并排序
-func (self *WuKongRanker) Rank(
-	docs []search.IndexedDocument, options search.RankOptions) (outputDocs search.ScoredDocuments) {
+// ctx被取消时放弃排序，返回nil
+func (self *WuKongRanker) Rank(ctx context.Context,
+	docs []search.IndexedDocument, options search.RankOptions) (outputDocs search.ScoredDocuments, numDocs int) {
 	if self.initialized == false {
-		log.Fatal("排序器尚未初始化")
+		return nil, 0
+	}
+
+	// 当只需要返回部分结果时，用大小为OutputOffset+MaxOutputs的堆保留排在
+	// 最前面的文档，不必对全部文档排序
+	numKeep := 0
+	if options.MaxOutputs != 0 && options.OutputOffset+options.MaxOutputs < len(docs) {
+		numKeep = options.OutputOffset + options.MaxOutputs
 	}
+	kept := &scoredDocumentHeap{options: &options}
 
 	// 对每个文档评分
-	for _, d := range docs {
+	for i, d := range docs {
+		if i%numDocsPerContextCheck == 0 && ctx.Err() != nil {
+			return nil, 0
+		}
 		self.lock.RLock()
 		fs := self.lock.fields[d.DocId]
 		self.lock.RUnlock()
 		// 计算评分并剔除没有分值的文档
 		scores := options.SearchScorer.Score(d, fs)
-		if len(scores) > 0 {
-			outputDocs = append(outputDocs, search.ScoredDocument{
-				DocId:                 d.DocId,
-				Scores:                scores,
-				TokenSnippetLocations: d.TokenSnippetLocations,
-				TokenLocations:        d.TokenLocations})
+		if len(scores) == 0 {
+			continue
+		}
+		numDocs++
+		doc := search.ScoredDocument{
+			DocId:                 d.DocId,
+			Scores:                scores,
+			TokenSnippetLocations: d.TokenSnippetLocations,
+			TokenLocations:        d.TokenLocations,
+			SortValues:            d.SortValues,
+			Explanation:           d.Explanation}
+		if doc.Explanation != nil {
+			doc.Explanation.Scores = scores
 		}
-	}
 
-	// 排序
-	if options.ReverseOrder {
-		sort.Sort(sort.Reverse(outputDocs))
-	} else {
-		sort.Sort(outputDocs)
+		// 按游标翻页时跳过之前各页已经输出的文档，numDocs仍然包括这些文档
+		if options.After != nil && !options.Before(options.After, &doc) {
+			continue
+		}
+		if numKeep == 0 {
+			outputDocs = append(outputDocs, doc)
+		} else if kept.Len() < numKeep {
+			heap.Push(kept, doc)
+		} else {
+			// 新文档排在堆顶文档前面时替换堆顶
+			kept.docs = append(kept.docs, doc)
+			if kept.Less(0, kept.Len()-1) {
+				kept.Swap(0, kept.Len()-1)
+				kept.docs = kept.docs[:kept.Len()-1]
+				heap.Fix(kept, 0)
+			} else {
+				kept.docs = kept.docs[:kept.Len()-1]
+			}
+		}
+	}
+	if numKeep != 0 {
+		outputDocs = kept.docs
 	}
 
+	// 排序，顺序见RankOptions.Before
+	sort.Slice(outputDocs, func(i, j int) bool {
+		return options.Before(&outputDocs[i], &outputDocs[j])
+	})
+
 	// 当用户要求只返回部分结果时返回部分结果
 	var start, end int
 	if options.MaxOutputs != 0 {
@@ -99,5 +146,31 @@ func (self *WuKongRanker) Rank(
 		start = utils.MinInt(options.OutputOffset, len(outputDocs))
 		end = len(outputDocs)
 	}
-	return outputDocs[start:end]
+	return outputDocs[start:end], numDocs
+}
+
+// 有界堆，堆顶是保留的文档中排序最靠后的一个
+type scoredDocumentHeap struct {
+	docs    search.ScoredDocuments
+	options *search.RankOptions
+}
+
+func (h *scoredDocumentHeap) Len() int {
+	return len(h.docs)
+}
+func (h *scoredDocumentHeap) Swap(i, j int) {
+	h.docs.Swap(i, j)
+}
+
+// 文档i排在文档j后面时返回true
+func (h *scoredDocumentHeap) Less(i, j int) bool {
+	return h.options.Before(&h.docs[j], &h.docs[i])
+}
+func (h *scoredDocumentHeap) Push(x interface{}) {
+	h.docs = append(h.docs, x.(search.ScoredDocument))
+}
+func (h *scoredDocumentHeap) Pop() interface{} {
+	last := h.docs[len(h.docs)-1]
+	h.docs = h.docs[:len(h.docs)-1]
+	return last
 }
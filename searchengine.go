@@ -10,14 +10,19 @@ package search
 import (
 	"bufio"
 	"bytes"
+	"container/heap"
+	"context"
 	"encoding/binary"
 	"encoding/gob"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"runtime"
-	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -46,15 +51,56 @@ type SearchRequest struct {
 	// 通常你不需要自己指定关键词，除非你运行自己的分词程序
 	Tokens []string
 
+	// 查询语法树，可以由ParseQuery从查询字符串生成，支持AND、OR、NOT和分组
+	// 不为nil时优先于Text和Tokens使用，语法树中的每个搜索键都会被分词
+	Query *Query
+
+	// 是否将Text或Tokens整体作为短语查询，要求关键词按顺序紧邻出现
+	// 不设置时Text中用双引号括起的部分也会作为短语查询
+	// 短语查询只在IndexType == LocationsIndex时检查关键词位置
+	Phrase bool
+
+	// 短语查询允许的关键词间隔，单位为字节，0表示关键词必须紧邻
+	// 间隔为相邻两个关键词之间跳过的字节数之和
+	Slop int
+
+	// 模糊匹配允许的最大编辑距离（按字符计算），为0时只做精确匹配
+	// 每个搜索键同时匹配索引中拼写相近的搜索键，编辑距离不超过搜索键字符数的一半，
+	// 距离每增加1得分减半。短语和排除条件中的搜索键不做模糊匹配
+	Fuzziness int
+
 	// 文档标签（必须是UTF-8格式），标签不存在文档文本中，但也属于搜索键的一种
 	Labels []string
 
+	// 属性字段的过滤条件，全部满足的文档才会被返回，见NewRangeFilter和NewTermFilter
+	Filters []AttributeFilter
+
+	// 分面统计，对全部命中的文档按标签前缀计数，结果见SearchResponse.Facets
+	Facets []FacetRequest
+
 	// 当不为空时，仅从这些文档中搜索
 	DocIds []uint64
 
-	// 排序选项
+	// 排序选项，为nil或者没有设置打分器时使用引擎初始化时设定的打分器
 	RankOptions *RankOptions
 
+	// 不为nil时为每个输出的文档生成高亮片段，见ScoredDocument.Fragments
+	// 需要设置EngineInitOptions.StoreContent，否则没有片段
+	Highlight *HighlightOptions
+
+	// 是否在ScoredDocument.Explanation中返回每个文档的打分过程，用于调试相关度
+	Explain bool
+
+	// 是否从持久化存储读取输出的文档，放在ScoredDocument.Document中
+	// 需要启用UsePersistentStorage，否则返回ErrNoStorage
+	IncludeDocuments bool
+
+	// 上一页返回的SearchResponse.Cursor，不为空时只返回排在该游标之后的文档
+	// 各shard只需保留MaxOutputs个文档，翻到多深代价都相同。查询和排序选项必须与
+	// 上一页相同，此时通常不再设置OutputOffset。游标无法解析或者排序选项与上一页
+	// 不同时返回ErrInvalidCursor
+	SearchAfter string
+
 	// 超时，单位毫秒（千分之一秒）。此值小于等于零时不设超时。
 	// 搜索超时的情况下仍有可能返回部分排序结果。
 	Timeout int
@@ -69,6 +115,41 @@ type SearchResponse struct {
 
 	// 搜索是否超时。超时的情况下也可能会返回部分结果
 	Timeout bool
+
+	// 满足搜索条件的文档总数，在分页之前计算
+	TotalHits int
+
+	// TotalHits是否精确。有shard超时的情况下，TotalHits只是实际命中数的下限
+	TotalHitsExact bool
+
+	// 每个shard的搜索情况，按shard编号排列
+	Shards []ShardStatus
+
+	// 分面统计结果，与SearchRequest.Facets一一对应。在分页之前对查找到的全部文档
+	// 计数，有shard超时的情况下只包含按时返回的shard。计数在打分之前进行，
+	// 包括被打分器剔除的文档，因此可能与TotalHits不一致，见FacetRequest的注释
+	Facets []FacetResult
+
+	// 指向本页最后一个文档的游标，放入下一次请求的SearchRequest.SearchAfter
+	// 即可取得下一页。本页没有文档时为空
+	Cursor string
+
+	// 没有任何文档命中时，由索引中存在的搜索键拼成的纠错查询，按得分从大到小排列
+	Suggestions []QuerySuggestion
+}
+
+// 一个shard的搜索情况
+type ShardStatus struct {
+	Shard int
+
+	// 该shard命中的文档数，在分页之前计算
+	NumHits int
+
+	// 从发出查找请求到该shard返回结果的耗时，超时的shard为等待的时间
+	Elapsed time.Duration
+
+	// 该shard是否没有在超时之前返回结果
+	Timeout bool
 }
 
 type ScoredDocument struct {
@@ -85,6 +166,18 @@ type ScoredDocument struct {
 	// 关键词出现的位置
 	// 只有当IndexType == LocationsIndex时不为空
 	TokenLocations [][]int
+
+	// 排序使用的属性字段取值，与RankOptions.Sort一一对应，按相关度排序的条件为无效值
+	SortValues []AttributeValue
+
+	// 高亮的片段，仅当设置了SearchRequest.Highlight时不为空
+	Fragments []string
+
+	// 持久化存储中的文档，仅当设置了SearchRequest.IncludeDocuments时读取
+	Document *DocumentIndexData
+
+	// 打分过程，仅当设置了SearchRequest.Explain时不为nil
+	Explanation *Explanation
 }
 
 type ScoredDocuments []ScoredDocument
@@ -96,57 +189,138 @@ func (docs ScoredDocuments) Swap(i, j int) {
 	docs[i], docs[j] = docs[j], docs[i]
 }
 func (docs ScoredDocuments) Less(i, j int) bool {
-	// 为了从大到小排序，这实际上实现的是More的功能
-	for iScore := 0; iScore < utils.MinInt(len(docs[i].Scores), len(docs[j].Scores)); iScore++ {
-		if docs[i].Scores[iScore] > docs[j].Scores[iScore] {
-			return true
-		} else if docs[i].Scores[iScore] < docs[j].Scores[iScore] {
-			return false
+	return moreScoredDocument(&docs[i], &docs[j])
+}
+
+// 为了从大到小排序，这实际上实现的是More的功能
+// 分数完全相同时DocId较大的文档排在前面，保证各shard和合并后的排序结果一致
+func moreScoredDocument(a, b *ScoredDocument) bool {
+	if c := compareScores(a.Scores, b.Scores); c != 0 {
+		return c > 0
+	}
+	return a.DocId > b.DocId
+}
+
+// 依次比较各个分数，a小于、等于、大于b时分别返回-1、0、1
+// 前面的分数都相同时分数较多的一方较大
+func compareScores(a, b []float32) int {
+	for iScore := 0; iScore < utils.MinInt(len(a), len(b)); iScore++ {
+		if a[iScore] > b[iScore] {
+			return 1
+		} else if a[iScore] < b[iScore] {
+			return -1
+		}
+	}
+	if len(a) != len(b) {
+		if len(a) > len(b) {
+			return 1
 		}
+		return -1
 	}
-	return len(docs[i].Scores) > len(docs[j].Scores)
+	return 0
 }
 
+// 分词请求，docs中的文档属于同一个shard
 type segmenterRequest struct {
-	docId uint64
-	hash  uint32
-	data  DocumentIndexData
+	docs []BatchDocument
+
+	// 分词完成后把索引请求放入这里，交给所在shard的索引协程
+	segmented chan indexerAddDocumentRequest
+
+	// 请求所属的刷新轮次，以及需要通知的凭据和批量结果（可以为nil）
+	// positions为docs在批量结果中的位置
+	epoch     uint64
+	ticket    *IndexTicket
+	batch     *IndexBatchResult
+	positions []int
 }
 
+// 发往索引协程的请求，同一shard的添加和删除请求按提交的顺序处理
+// 分词可以由多个协程并行完成，索引协程按顺序等待每个请求的分词结果，
+// 保证同一文档先后提交的版本和删除按提交顺序生效
+type indexerRequest struct {
+	// 添加文档时不为nil，分词完成后收到索引请求
+	segmented chan indexerAddDocumentRequest
+
+	// 删除文档时不为nil
+	remove *indexerRemoveDocumentRequest
+}
+
+// 索引和评分字段放在同一个请求中，由所在shard的索引协程依次替换，
+// 保证同一文档的反向索引和评分字段总是成对更新
 type indexerAddDocumentRequest struct {
-	document *DocumentIndex
+	documents []*DocumentIndex
+	fields    []interface{}
+	contents  []string
+	epoch     uint64
+	ticket    *IndexTicket
+	batch     *IndexBatchResult
+	positions []int
 }
 
 type indexerLookupRequest struct {
-	tokens              []string
+	ctx                 context.Context
+	query               *Query
 	labels              []string
+	filters             []LookupFilter
+	sortFields          []int
+	explain             bool
+	facets              []FacetRequest
 	docIds              []uint64
+	stats               *CorpusStats
 	options             RankOptions
+	startTime           time.Time
 	rankerReturnChannel chan rankerReturnRequest
 }
 
-type rankerAddScoringFieldsRequest struct {
-	docId  uint64
-	fields interface{}
-}
-
 type rankerRankRequest struct {
+	ctx                 context.Context
 	docs                []IndexedDocument
+	facetCounts         []map[string]int
+	lookup              *indexerLookupRequest // 查找时的请求和shard版本，排序时版本已经改变则重新查找
+	version             uint64
 	options             RankOptions
+	startTime           time.Time
 	rankerReturnChannel chan rankerReturnRequest
 }
 
 type rankerReturnRequest struct {
 	docs ScoredDocuments
+
+	// 用于统计的shard编号、分页之前的命中数和耗时
+	shard   int
+	numDocs int
+	elapsed time.Duration
+
+	// 各分面的标签计数
+	facetCounts []map[string]int
+}
+
+// 一个shard的反向索引和评分字段的版本
+// 索引协程在写锁内先后替换反向索引项和评分字段并增加version，查找和排序分别在
+// 读锁内进行。排序时的版本与查找时不同，说明其间有文档被替换或删除，此时在同一个
+// 读锁内重新查找，保证打分使用的索引项和评分字段属于文档的同一个版本
+type shardVersion struct {
+	sync.RWMutex
+	version uint64
 }
 
-type rankerRemoveScoringFieldsRequest struct {
+type indexerRemoveDocumentRequest struct {
 	docId uint64
+	epoch uint64
 }
 
+// 持久化存储请求，docs中的文档属于同一个存储shard
+// 同一存储shard的写入和删除由一个协程按提交顺序执行
 type persistentStorageIndexDocumentRequest struct {
-	docId uint64
-	data  DocumentIndexData
+	docs []BatchDocument
+
+	// 为true时从数据库中删除docs中的文档
+	remove bool
+
+	epoch     uint64
+	batch     *IndexBatchResult
+	positions []int
 }
 
 //排序选项
@@ -155,13 +329,23 @@ type RankOptions struct {
 	SearchScorer SearchScorer
 
 	// 默认情况下（ReverseOrder=false）按照分数从大到小排序，否则从小到大排序
+	// 设置了Sort时不起作用
 	ReverseOrder bool
 
+	// 按属性字段排序，依次比较各个条件，可以用SortByScore与相关度组合，比如
+	// []SortField{{Field: "date", Descending: true}, {Field: SortByScore, Descending: true}}
+	// 为空时只按分数排序，见RankOptions.Before
+	Sort []SortField
+
 	// 从第几条结果开始输出
 	OutputOffset int
 
 	// 最大输出的搜索结果数，为0时无限制
 	MaxOutputs int
+
+	// 不为nil时只输出按上面的顺序排在After之后的文档，只比较DocId、Scores和SortValues
+	// 通常由引擎按SearchRequest.SearchAfter设置
+	After *ScoredDocument
 }
 
 type EngineInitOptions struct {
@@ -172,6 +356,17 @@ type EngineInitOptions struct {
 	// 停用词文件
 	StopTokenFile string
 
+	// 同义词文件，格式见Synonyms的注释
+	SynonymFile string
+
+	// 为true时在建立索引时展开同义词，把文档中的关键词的同义词加入索引；
+	// 否则在搜索时把搜索键展开为同义词组。前者搜索更快，但修改同义词后需要重建索引
+	IndexSynonyms bool
+
+	// 不为nil时为中文关键词索引全拼和首字母，搜索时只由英文字母组成的搜索键按拼音匹配，
+	// 比如"beijing"和"bj"都能搜到"北京"。可以使用内置拼音表pinyin.NewPinyinTable()
+	Pinyin SearchPinyin
+
 	// 分词器线程数
 	NumSegmenterThreads int
 
@@ -208,6 +403,16 @@ type EngineInitOptions struct {
 
 	//打分器设置
 	SearchScorer SearchScorer
+
+	// 文档属性字段的定义，字段名不能重复
+	// 文档在DocumentIndexData.Attributes中设置取值，搜索时可以按字段过滤
+	Attributes []AttributeField
+
+	// 是否在内存中保存文档内容（DocumentIndexData.Content），用于生成高亮片段
+	StoreContent bool
+
+	// 是否用已索引的关键词建立前缀树，提供输入提示，见Engine.Suggest
+	EnableSuggestions bool
 }
 
 var (
@@ -269,7 +474,9 @@ type Engine struct {
 	numDocumentsIndexed uint64
 	numIndexingRequests uint64
 	numTokenIndexAdded  uint64
-	numDocumentsStored  uint64
+
+	// 尚未完成的索引和删除请求，用于FlushIndex
+	pending pendingRequests
 
 	// 记录初始化参数
 	initOptions EngineInitOptions
@@ -279,71 +486,146 @@ type Engine struct {
 	rankers    []SearchRanker
 	segmenter  SearchSegmenter
 	stopTokens StopTokens
+	synonyms   Synonyms
+	attributes *attributeSchema
+	contents   []*contentStore
+	suggester  *suggestTrie
 	//dbs        []*kv.DB
 	searchpipline SearchPipline
 
+	// 按分词结果整理的同义词规则，见synonymRules
+	synonymRules *synonymRules
+
+	// 各shard的版本，见shardVersion
+	shardVersions []shardVersion
+
 	// 建立索引器使用的通信通道
-	segmenterChannel               chan segmenterRequest
-	indexerAddDocumentChannels     []chan indexerAddDocumentRequest
-	rankerAddScoringFieldsChannels []chan rankerAddScoringFieldsRequest
+	segmenterChannel chan segmenterRequest
+	indexerChannels  []chan indexerRequest
 
 	// 建立排序器使用的通信通道
-	indexerLookupChannels             []chan indexerLookupRequest
-	rankerRankChannels                []chan rankerRankRequest
-	rankerRemoveScoringFieldsChannels []chan rankerRemoveScoringFieldsRequest
+	indexerLookupChannels []chan indexerLookupRequest
+	rankerRankChannels    []chan rankerRankRequest
 
 	// 建立持久存储使用的通信通道
 	persistentStorageIndexDocumentChannels []chan persistentStorageIndexDocumentRequest
-	persistentStorageInitChannel           chan bool
+	persistentStorageInitChannel           chan error
+
+	// 持久化存储中处于打开状态的shard，初始化失败时由abortInit关闭
+	openStorageShards []bool
+
+	// 关闭引擎使用的状态
+	// closed置位后不再接受新的请求，requests记录正在执行的索引和搜索调用，
+	// done关闭后各工作协程退出，workers记录仍在运行的工作协程
+	closeLock sync.RWMutex
+	closeOnce sync.Once
+	closed    bool
+	requests  sync.WaitGroup
+	done      chan struct{}
+	workers   sync.WaitGroup
 }
 
 func NewSearchEngine() *Engine {
 	return &Engine{}
 }
 
-func (engine *Engine) Init(options EngineInitOptions) {
+// 初始化引擎并启动工作协程
+//
+// 选项无效、停用词文件无法载入或者索引器、排序器初始化失败时返回错误，此时
+// 引擎保持未初始化的状态，可以改正选项后重新调用Init。启用持久化存储时，
+// 存储的初始化和数据恢复失败会关闭已经启动的工作协程和已经打开的存储并返回
+// 错误，之后引擎的调用返回ErrEngineClosed。
+func (engine *Engine) Init(options EngineInitOptions) error {
 	// 将线程数设置为CPU数
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
 	// 初始化初始参数
 	if engine.initialized {
-		log.Fatal("请勿重复初始化引擎")
+		return ErrAlreadyInitialized
+	}
+	if options.CreateIndexer == nil || options.CreateRanker == nil ||
+		(options.UsePersistentStorage && options.SearchPipline == nil) {
+		return ErrInvalidOptions
 	}
 	options.Init()
-	engine.initOptions = options
-	engine.initialized = true
 
-	// 载入分词器词典
-	//engine.segmenter.LoadDictionary(options.SegmenterDictionaries)
-	//将词典载入单独分离出来
-	engine.segmenter = options.Segmenter
+	// 检查属性字段的定义，并交给索引器
+	attributes, err := newAttributeSchema(options.Attributes)
+	if err != nil {
+		return err
+	}
+	indexerInitOptions := *options.IndexerInitOptions
+	indexerInitOptions.Attributes = options.Attributes
+	options.IndexerInitOptions = &indexerInitOptions
 
 	// 初始化停用词
-	engine.stopTokens.Init(options.StopTokenFile)
+	var stopTokens StopTokens
+	if err := stopTokens.Init(options.StopTokenFile); err != nil {
+		return err
+	}
+
+	// 初始化同义词
+	var synonyms Synonyms
+	if err := synonyms.Init(options.SynonymFile); err != nil {
+		return err
+	}
 
 	// 初始化索引器和排序器
+	indexers := make([]SearchIndexer, options.NumShards)
+	rankers := make([]SearchRanker, options.NumShards)
 	for shard := 0; shard < options.NumShards; shard++ {
-		//engine.indexers = append(engine.indexers, Indexer{})
 		//利用索引器生成方法生成索引器列表
-		engine.indexers = append(engine.indexers, options.CreateIndexer())
-		engine.indexers[shard].Init(*options.IndexerInitOptions)
+		indexers[shard] = options.CreateIndexer()
+		if err := indexers[shard].Init(*options.IndexerInitOptions); err != nil {
+			return err
+		}
+
+		rankers[shard] = options.CreateRanker()
+		if err := rankers[shard].Init(); err != nil {
+			return err
+		}
+	}
 
-		engine.rankers = append(engine.rankers, options.CreateRanker())
-		engine.rankers[shard].Init()
+	engine.initOptions = options
+	engine.initialized = true
+	engine.stopTokens = stopTokens
+	engine.synonyms = synonyms
+	engine.indexers = indexers
+	engine.rankers = rankers
+	engine.shardVersions = make([]shardVersion, options.NumShards)
+	engine.attributes = attributes
+	if options.StoreContent {
+		engine.contents = make([]*contentStore, options.NumShards)
+		for shard := range engine.contents {
+			engine.contents[shard] = newContentStore()
+		}
+	}
+	if options.EnableSuggestions {
+		engine.suggester = newSuggestTrie()
 	}
 
+	// 载入分词器词典
+	//engine.segmenter.LoadDictionary(options.SegmenterDictionaries)
+	//将词典载入单独分离出来
+	engine.segmenter = options.Segmenter
+	engine.synonymRules = engine.compileSynonyms()
+
+	// 初始化关闭通道和刷新屏障
+	engine.done = make(chan struct{})
+	engine.pending.init()
+
 	// 初始化分词器通道
 	engine.segmenterChannel = make(
 		chan segmenterRequest, options.NumSegmenterThreads)
 
 	// 初始化索引器通道
-	engine.indexerAddDocumentChannels = make(
-		[]chan indexerAddDocumentRequest, options.NumShards)
+	engine.indexerChannels = make(
+		[]chan indexerRequest, options.NumShards)
 	engine.indexerLookupChannels = make(
 		[]chan indexerLookupRequest, options.NumShards)
 	for shard := 0; shard < options.NumShards; shard++ {
-		engine.indexerAddDocumentChannels[shard] = make(
-			chan indexerAddDocumentRequest,
+		engine.indexerChannels[shard] = make(
+			chan indexerRequest,
 			options.IndexerBufferLength)
 		engine.indexerLookupChannels[shard] = make(
 			chan indexerLookupRequest,
@@ -351,26 +633,16 @@ func (engine *Engine) Init(options EngineInitOptions) {
 	}
 
 	// 初始化排序器通道
-	engine.rankerAddScoringFieldsChannels = make(
-		[]chan rankerAddScoringFieldsRequest, options.NumShards)
 	engine.rankerRankChannels = make(
 		[]chan rankerRankRequest, options.NumShards)
-	engine.rankerRemoveScoringFieldsChannels = make(
-		[]chan rankerRemoveScoringFieldsRequest, options.NumShards)
 	for shard := 0; shard < options.NumShards; shard++ {
-		engine.rankerAddScoringFieldsChannels[shard] = make(
-			chan rankerAddScoringFieldsRequest,
-			options.RankerBufferLength)
 		engine.rankerRankChannels[shard] = make(
 			chan rankerRankRequest,
 			options.RankerBufferLength)
-		engine.rankerRemoveScoringFieldsChannels[shard] = make(
-			chan rankerRemoveScoringFieldsRequest,
-			options.RankerBufferLength)
 	}
 
 	// 初始化持久化存储通道
-	if engine.initOptions.UsePersistentStorage && engine.initOptions.SearchPipline != nil {
+	if engine.initOptions.UsePersistentStorage {
 		storageshards := engine.initOptions.SearchPipline.GetStorageShards()
 		engine.persistentStorageIndexDocumentChannels =
 			make([]chan persistentStorageIndexDocumentRequest,
@@ -380,87 +652,159 @@ func (engine *Engine) Init(options EngineInitOptions) {
 				chan persistentStorageIndexDocumentRequest)
 		}
 		engine.persistentStorageInitChannel = make(
-			chan bool, storageshards)
+			chan error, storageshards)
 	}
 
 	// 启动分词器
 	for iThread := 0; iThread < options.NumSegmenterThreads; iThread++ {
-		go engine.segmenterWorker()
+		engine.startWorker(engine.segmenterWorker)
 	}
 
 	// 启动索引器和排序器
 	for shard := 0; shard < options.NumShards; shard++ {
-		go engine.indexerAddDocumentWorker(shard)
-		go engine.rankerAddScoringFieldsWorker(shard)
-		go engine.rankerRemoveScoringFieldsWorker(shard)
+		shard := shard
+		engine.startWorker(func() { engine.indexerWorker(shard) })
 
 		for i := 0; i < options.NumIndexerThreadsPerShard; i++ {
-			go engine.indexerLookupWorker(shard)
+			engine.startWorker(func() { engine.indexerLookupWorker(shard) })
 		}
 		for i := 0; i < options.NumRankerThreadsPerShard; i++ {
-			go engine.rankerRankWorker(shard)
+			engine.startWorker(func() { engine.rankerRankWorker(shard) })
 		}
 	}
 
 	// 启动持久化存储工作协程
 	if engine.initOptions.UsePersistentStorage {
-		engine.searchpipline = options.SearchPipline
-		engine.searchpipline.Init()
+		if err := engine.initPersistentStorage(); err != nil {
+			engine.abortInit()
+			return err
+		}
+	}
 
-		storageshards := engine.searchpipline.GetStorageShards()
-		// 从数据库中恢复
-		for shard := 0; shard < storageshards; shard++ {
-			go engine.persistentStorageInitWorker(shard)
+	return nil
+}
+
+// 初始化持久化存储，从中恢复索引后启动存储工作协程
+func (engine *Engine) initPersistentStorage() error {
+	engine.searchpipline = engine.initOptions.SearchPipline
+	if err := engine.searchpipline.Init(); err != nil {
+		return err
+	}
+
+	storageshards := engine.searchpipline.GetStorageShards()
+	engine.openStorageShards = make([]bool, storageshards)
+	for shard := range engine.openStorageShards {
+		engine.openStorageShards[shard] = true
+	}
+	// 从数据库中恢复
+	for shard := 0; shard < storageshards; shard++ {
+		go engine.persistentStorageInitWorker(shard)
+	}
+
+	// 等待恢复完成，全部shard都返回后再处理错误
+	var recoverErr error
+	for shard := 0; shard < storageshards; shard++ {
+		if err := <-engine.persistentStorageInitChannel; err != nil && recoverErr == nil {
+			recoverErr = err
 		}
+	}
+	engine.FlushIndex()
+	if recoverErr != nil {
+		return recoverErr
+	}
 
-		// 等待恢复完成
-		for shard := 0; shard < storageshards; shard++ {
-			<-engine.persistentStorageInitChannel
+	// 关闭并重新打开数据库
+	for shard := 0; shard < storageshards; shard++ {
+		engine.openStorageShards[shard] = false
+		if err := engine.searchpipline.Close(shard); err != nil {
+			return err
 		}
-		for {
-			runtime.Gosched()
-			if engine.numIndexingRequests == engine.numDocumentsIndexed {
-				break
-			}
+		if err := engine.searchpipline.Conn(shard); err != nil {
+			return err
 		}
+		engine.openStorageShards[shard] = true
+	}
 
-		// 关闭并重新打开数据库
-		for shard := 0; shard < storageshards; shard++ {
-			engine.searchpipline.Close(shard)
-			engine.searchpipline.Conn(shard)
-		}
+	for shard := 0; shard < storageshards; shard++ {
+		shard := shard
+		engine.startWorker(func() { engine.persistentStorageIndexDocumentWorker(shard) })
+	}
+	return nil
+}
 
-		for shard := 0; shard < storageshards; shard++ {
-			go engine.persistentStorageIndexDocumentWorker(shard)
+// 初始化中途失败时停止已经启动的工作协程，并关闭已经打开的持久化存储，
+// 避免泄漏数据库连接和文件锁。之后的调用返回ErrEngineClosed
+func (engine *Engine) abortInit() {
+	engine.closeOnce.Do(func() {
+		engine.closed = true
+		close(engine.done)
+		engine.workers.Wait()
+
+		for shard, open := range engine.openStorageShards {
+			if !open {
+				continue
+			}
+			if err := engine.searchpipline.Close(shard); err != nil {
+				log.Println(err)
+			}
 		}
-	}
+	})
+}
 
-	atomic.AddUint64(&engine.numDocumentsStored, engine.numIndexingRequests)
+// 启动一个工作协程，Close会等待它退出
+func (engine *Engine) startWorker(worker func()) {
+	engine.workers.Add(1)
+	go func() {
+		defer engine.workers.Done()
+		worker()
+	}()
 }
 
-func (engine *Engine) rankerAddScoringFieldsWorker(shard int) {
-	for {
-		request := <-engine.rankerAddScoringFieldsChannels[shard]
-		engine.rankers[shard].AddScoringFields(request.docId, request.fields)
+// 登记一次索引或者搜索调用，引擎已关闭时返回ErrEngineClosed
+// 登记成功后调用者在返回前必须调用engine.requests.Done()
+func (engine *Engine) enter() error {
+	engine.closeLock.RLock()
+	defer engine.closeLock.RUnlock()
+	if engine.closed {
+		return ErrEngineClosed
 	}
+	engine.requests.Add(1)
+	return nil
 }
 
 func (engine *Engine) rankerRankWorker(shard int) {
 	for {
-		request := <-engine.rankerRankChannels[shard]
+		var request rankerRankRequest
+		select {
+		case request = <-engine.rankerRankChannels[shard]:
+		case <-engine.done:
+			return
+		}
+		// 搜索已经结束时不再排序
+		if request.ctx.Err() != nil {
+			continue
+		}
 		if request.options.MaxOutputs != 0 {
 			request.options.MaxOutputs += request.options.OutputOffset
 		}
 		request.options.OutputOffset = 0
-		outputDocs := engine.rankers[shard].Rank(request.docs, request.options)
-		request.rankerReturnChannel <- rankerReturnRequest{docs: outputDocs}
-	}
-}
-
-func (engine *Engine) rankerRemoveScoringFieldsWorker(shard int) {
-	for {
-		request := <-engine.rankerRemoveScoringFieldsChannels[shard]
-		engine.rankers[shard].RemoveScoringFields(request.docId)
+		version := &engine.shardVersions[shard]
+		version.RLock()
+		if version.version != request.version {
+			// 查找之后有文档被替换或删除，重新查找
+			request.docs, request.facetCounts, _ = engine.lookupShard(shard, request.lookup)
+		}
+		outputDocs, numDocs := engine.rankers[shard].Rank(request.ctx, request.docs, request.options)
+		version.RUnlock()
+		if request.ctx.Err() != nil {
+			continue
+		}
+		request.rankerReturnChannel <- rankerReturnRequest{
+			docs:        outputDocs,
+			shard:       shard,
+			numDocs:     numDocs,
+			elapsed:     time.Since(request.startTime),
+			facetCounts: request.facetCounts}
 	}
 }
 
@@ -473,25 +817,83 @@ func (engine *Engine) rankerRemoveScoringFieldsWorker(shard int) {
 // 注意：
 //      1. 这个函数是线程安全的，请尽可能并发调用以提高索引速度
 // 	2. 这个函数调用是非同步的，也就是说在函数返回时有可能文档还没有加入索引中，因此
-//         如果立刻调用Search可能无法查询到这个文档。强制刷新索引请调用FlushIndex函数。
-func (engine *Engine) IndexDocument(docId uint64, data DocumentIndexData) {
-	engine.internalIndexDocument(docId, data)
+//         如果立刻调用Search可能无法查询到这个文档。强制刷新索引请调用FlushIndex函数，
+//         等待单个文档请使用IndexDocumentTicket。
+//	3. 文档按docId分配shard，重复索引同一docId会替换旧的文档，见UpdateDocument。
+//	4. 引擎尚未初始化时返回ErrNotInitialized，关闭后返回ErrEngineClosed，
+//	   data.Attributes使用了未定义的字段或者取值类型不符时返回ErrInvalidAttribute。
+func (engine *Engine) IndexDocument(docId uint64, data DocumentIndexData) error {
+	return engine.indexDocument(docId, data, nil)
+}
 
-	if engine.initOptions.UsePersistentStorage {
-		hash := utils.Murmur3([]byte(fmt.Sprint("%d", docId))) % uint32(engine.searchpipline.GetStorageShards())
-		engine.persistentStorageIndexDocumentChannels[hash] <- persistentStorageIndexDocumentRequest{docId: docId, data: data}
+// 将文档加入索引，并返回该文档的凭据
+// 用法同IndexDocument，调用ticket.Wait可以等到该文档能被Search查找到为止，
+// 不必等待其他文档的索引完成
+func (engine *Engine) IndexDocumentTicket(docId uint64, data DocumentIndexData) (*IndexTicket, error) {
+	ticket := newIndexTicket(docId)
+	if err := engine.indexDocument(docId, data, ticket); err != nil {
+		return nil, err
 	}
+	return ticket, nil
 }
 
-func (engine *Engine) internalIndexDocument(docId uint64, data DocumentIndexData) {
+func (engine *Engine) indexDocument(docId uint64, data DocumentIndexData, ticket *IndexTicket) error {
 	if !engine.initialized {
-		log.Fatal("必须先初始化引擎")
+		return ErrNotInitialized
+	}
+	if _, err := engine.attributes.values(data.Attributes); err != nil {
+		return err
 	}
+	if err := engine.enter(); err != nil {
+		return err
+	}
+	defer engine.requests.Done()
+
+	// 写入索引，启用持久化存储时还要写入数据库
+	steps := 1
+	if engine.initOptions.UsePersistentStorage {
+		steps++
+	}
+	epoch := engine.pending.begin(steps)
+	engine.sendToSegmenter(docId, data, epoch, ticket)
+
+	if engine.initOptions.UsePersistentStorage {
+		shard := engine.getStorageShard(docId)
+		engine.persistentStorageIndexDocumentChannels[shard] <- persistentStorageIndexDocumentRequest{
+			docs: []BatchDocument{{DocId: docId, Data: data}}, epoch: epoch}
+	}
+	return nil
+}
 
+// 用新的数据替换已索引的文档
+//
+// 同一docId先后提交的版本按提交顺序生效，最后提交的版本总是留在索引中。
+// 旧文档中不再出现的关键词会从反向索引表中删除。反向索引项和评分字段在所在
+// shard的写锁内一起替换，同时进行的搜索看到的要么全是旧版本、要么全是新版本，
+// 不会用旧的评分字段给新的索引项打分，见shardVersion。
+// 当docId尚未被索引时等同于IndexDocument。调用同样是非同步的。
+func (engine *Engine) UpdateDocument(docId uint64, data DocumentIndexData) error {
+	return engine.IndexDocument(docId, data)
+}
+
+// 从持久化存储恢复的文档只需要写入索引
+func (engine *Engine) internalIndexDocument(docId uint64, data DocumentIndexData) {
+	engine.sendToSegmenter(docId, data, engine.pending.begin(1), nil)
+}
+
+func (engine *Engine) sendToSegmenter(docId uint64, data DocumentIndexData, epoch uint64, ticket *IndexTicket) {
 	atomic.AddUint64(&engine.numIndexingRequests, 1)
-	hash := utils.Murmur3([]byte(fmt.Sprint("%d%s", docId, data.Content)))
-	engine.segmenterChannel <- segmenterRequest{
-		docId: docId, hash: hash, data: data}
+	engine.submitSegmenterRequest(segmenterRequest{
+		docs: []BatchDocument{{DocId: docId, Data: data}}, epoch: epoch, ticket: ticket})
+}
+
+// 先在所在shard的索引队列中占好位置，再交给分词协程
+// 索引协程按占位的顺序处理，分词完成的先后不影响文档生效的顺序
+func (engine *Engine) submitSegmenterRequest(request segmenterRequest) {
+	request.segmented = make(chan indexerAddDocumentRequest, 1)
+	shard := engine.getShard(request.docs[0].DocId)
+	engine.indexerChannels[shard] <- indexerRequest{segmented: request.segmented}
+	engine.segmenterChannel <- request
 }
 
 // 将文档从索引中删除
@@ -499,319 +901,839 @@ func (engine *Engine) internalIndexDocument(docId uint64, data DocumentIndexData
 // 输入参数：
 // 	docId	标识文档编号，必须唯一
 //
-// 注意：这个函数仅从排序器中删除文档的自定义评分字段，索引器不会发生变化。所以
-// 你的自定义评分字段必须能够区别评分字段为nil的情况，并将其从排序结果中删除。
-func (engine *Engine) RemoveDocument(docId uint64) {
+// 注意：文档的反向索引项和排序器中的评分字段会一并删除，删除后的文档不会再
+// 出现在搜索结果中。删除与之前提交的索引按提交顺序执行，IndexDocument之后
+// 立即RemoveDocument不会留下该文档，持久化存储中的写入和删除也是如此。
+// 这个函数调用是非同步的，强制刷新请调用FlushIndex函数。
+// 引擎尚未初始化时返回ErrNotInitialized，关闭后返回ErrEngineClosed。
+func (engine *Engine) RemoveDocument(docId uint64) error {
 	if !engine.initialized {
-		log.Fatal("必须先初始化引擎")
+		return ErrNotInitialized
+	}
+	if err := engine.enter(); err != nil {
+		return err
 	}
+	defer engine.requests.Done()
 
-	for shard := 0; shard < engine.initOptions.NumShards; shard++ {
-		engine.rankerRemoveScoringFieldsChannels[shard] <- rankerRemoveScoringFieldsRequest{docId: docId}
+	// 从索引中删除，启用持久化存储时还要从数据库中删除
+	steps := 1
+	if engine.initOptions.UsePersistentStorage {
+		steps++
 	}
+	epoch := engine.pending.begin(steps)
+	shard := engine.getShard(docId)
+	engine.indexerChannels[shard] <- indexerRequest{
+		remove: &indexerRemoveDocumentRequest{docId: docId, epoch: epoch}}
 
 	if engine.initOptions.UsePersistentStorage {
 		// 从数据库中删除
-		hash := utils.Murmur3([]byte(fmt.Sprint("%d", docId))) % uint32(engine.searchpipline.GetStorageShards())
-		go engine.persistentStorageRemoveDocumentWorker(docId, hash)
+		shard := engine.getStorageShard(docId)
+		engine.persistentStorageIndexDocumentChannels[shard] <- persistentStorageIndexDocumentRequest{
+			docs: []BatchDocument{{DocId: docId}}, remove: true, epoch: epoch}
 	}
+	return nil
 }
 
-// 阻塞等待直到所有索引添加完毕
+// 阻塞等待直到调用之前提交的索引添加和删除全部完毕
+// 调用期间新提交的请求不会延长等待
 func (engine *Engine) FlushIndex() {
-	for {
-		runtime.Gosched()
-		if engine.numIndexingRequests == engine.numDocumentsIndexed &&
-			(!engine.initOptions.UsePersistentStorage ||
-				engine.numIndexingRequests == engine.numDocumentsStored) {
-			return
-		}
+	engine.FlushIndexContext(context.Background())
+}
+
+// 同FlushIndex，ctx被取消或者超时后不再等待并返回ctx.Err()
+func (engine *Engine) FlushIndexContext(ctx context.Context) error {
+	if !engine.initialized {
+		return ErrNotInitialized
 	}
+	return engine.pending.wait(ctx)
 }
 
 func (engine *Engine) segmenterWorker() {
 	for {
-		request := <-engine.segmenterChannel
-		shard := engine.getShard(request.hash)
-
-		tokensMap := make(map[string][]int)
-		numTokens := 0
-		if request.data.Content != "" {
-			// 当文档正文不为空时，优先从内容分词中得到关键词
-			segments := engine.segmenter.Cut([]byte(request.data.Content), true)
-			for _, segment := range segments {
-				token := segment.GetToken().GetText()
-				if !engine.stopTokens.IsStopToken(token) {
-					tokensMap[token] = append(tokensMap[token], segment.GetStart())
-				}
-			}
-			numTokens = len(segments)
-		} else {
-			// 否则载入用户输入的关键词
-			for _, t := range request.data.Tokens {
-				if !engine.stopTokens.IsStopToken(t.Text) {
-					tokensMap[t.Text] = t.Locations
-				}
-			}
-			numTokens = len(request.data.Tokens)
+		var request segmenterRequest
+		select {
+		case request = <-engine.segmenterChannel:
+		case <-engine.done:
+			return
 		}
 
-		// 加入非分词的文档标签
-		for _, label := range request.data.Labels {
-			if !engine.stopTokens.IsStopToken(label) {
-				tokensMap[label] = []int{}
-			}
+		addRequest := indexerAddDocumentRequest{
+			documents: make([]*DocumentIndex, len(request.docs)),
+			fields:    make([]interface{}, len(request.docs)),
+			contents:  make([]string, len(request.docs)),
+			epoch:     request.epoch,
+			ticket:    request.ticket,
+			batch:     request.batch,
+			positions: request.positions,
+		}
+		for i, doc := range request.docs {
+			addRequest.documents[i] = engine.segmentDocument(doc.DocId, doc.Data)
+			addRequest.fields[i] = doc.Data.Fields
+			addRequest.contents[i] = doc.Data.Content
 		}
+		request.segmented <- addRequest
+	}
+}
 
-		indexerRequest := indexerAddDocumentRequest{
-			document: &DocumentIndex{
-				DocId:       request.docId,
-				TokenLength: float32(numTokens),
-				Keywords:    make([]KeywordIndex, len(tokensMap)),
-			},
+// 对文档分词，生成加入反向索引的关键词
+func (engine *Engine) segmentDocument(docId uint64, data DocumentIndexData) *DocumentIndex {
+	tokensMap := make(map[string][]int)
+	numTokens := 0
+	if data.Content != "" {
+		// 当文档正文不为空时，优先从内容分词中得到关键词
+		segments := engine.segmenter.Cut([]byte(data.Content), true)
+		for _, segment := range segments {
+			token := segment.GetToken().GetText()
+			if !engine.stopTokens.IsStopToken(token) {
+				tokensMap[token] = append(tokensMap[token], segment.GetStart())
+			}
 		}
-		iTokens := 0
-		for k, v := range tokensMap {
-			indexerRequest.document.Keywords[iTokens] = KeywordIndex{
-				Text: k,
-				// 非分词标注的词频设置为0，不参与tf-idf计算
-				Frequency: float32(len(v)),
-				Starts:    v}
-			iTokens++
+		numTokens = len(segments)
+	} else {
+		// 否则载入用户输入的关键词，关键词与分词结果一样做归一化
+		for _, t := range data.Tokens {
+			text := NormalizeText(t.Text)
+			if engine.stopTokens.IsStopToken(text) {
+				continue
+			}
+			if _, found := tokensMap[text]; found {
+				// 繁简不同的关键词归一化后相同，合并它们的位置
+				mergeLocations(tokensMap, map[string][]int{text: t.Locations})
+			} else {
+				tokensMap[text] = t.Locations
+			}
+		}
+		numTokens = len(data.Tokens)
+	}
+	engine.indexSynonyms(tokensMap)
+	pinyin := engine.indexPinyin(tokensMap)
+
+	// 加入非分词的文档标签
+	var labels []string
+	for _, label := range data.Labels {
+		if !engine.stopTokens.IsStopToken(label) {
+			tokensMap[label] = []int{}
+			labels = append(labels, label)
 		}
-		engine.indexerAddDocumentChannels[shard] <- indexerRequest
-		rankerRequest := rankerAddScoringFieldsRequest{
-			docId: request.docId, fields: request.data.Fields}
-		engine.rankerAddScoringFieldsChannels[shard] <- rankerRequest
 	}
+
+	// 属性字段已在提交时检查过，从持久化存储恢复的文档与当前字段定义不符时忽略其属性
+	attributes, err := engine.attributes.values(data.Attributes)
+	if err != nil {
+		log.Printf("文档 %d 的属性被忽略: %v", docId, err)
+	}
+
+	document := &DocumentIndex{
+		DocId:       docId,
+		TokenLength: float32(numTokens),
+		Keywords:    make([]KeywordIndex, len(tokensMap)),
+		Labels:      labels,
+		Pinyin:      pinyin,
+		Attributes:  attributes,
+	}
+	iTokens := 0
+	for k, v := range tokensMap {
+		document.Keywords[iTokens] = KeywordIndex{
+			Text: k,
+			// 非分词标注的词频设置为0，不参与tf-idf计算
+			Frequency: float32(len(v)),
+			Starts:    v}
+		iTokens++
+	}
+	return document
 }
 
 // 查找满足搜索条件的文档，此函数线程安全
-func (engine *Engine) Search(request SearchRequest) (output SearchResponse) {
+// request.Timeout大于零时，超时后返回已收到的部分结果并设置SearchResponse.Timeout
+// 引擎尚未初始化时返回ErrNotInitialized，关闭后返回ErrEngineClosed，
+// 没有可用的打分器时返回ErrNoScorer，过滤条件无效时返回ErrInvalidFilter，
+// 排序条件无效时返回ErrInvalidSort，游标无效时返回ErrInvalidCursor，
+// request.Query中排除条件的位置不符合ParseQuery的规定时返回ErrQuerySyntax，
+// 设置了IncludeDocuments却没有启用持久化存储时返回ErrNoStorage
+func (engine *Engine) Search(request SearchRequest) (output SearchResponse, err error) {
+	return engine.SearchContext(context.Background(), request)
+}
+
+// 查找满足搜索条件的文档，此函数线程安全
+//
+// ctx被取消或者超时后立即返回已收到的部分结果，同时返回ctx.Err()。各shard的
+// 索引器和排序器会检查ctx，停止处理已经没有人等待的请求。
+// request.Timeout大于零时在ctx上附加相应的超时，该超时只设置SearchResponse.Timeout，
+// 不作为错误返回。
+func (engine *Engine) SearchContext(ctx context.Context, request SearchRequest) (output SearchResponse, err error) {
 	if !engine.initialized {
-		log.Fatal("必须先初始化引擎")
+		return output, ErrNotInitialized
 	}
+	if err = engine.enter(); err != nil {
+		return
+	}
+	defer engine.requests.Done()
 
+	// 没有设置排序选项或者打分器时使用初始化时设定的打分器
 	var rankOptions RankOptions
-	rankOptions.SearchScorer = engine.initOptions.SearchScorer
-	if request.RankOptions == nil {
-		log.Println("必须设置搜索排序选项")
-		return
-	} else {
+	if request.RankOptions != nil {
 		rankOptions = *request.RankOptions
 	}
 	if rankOptions.SearchScorer == nil {
-		log.Println("必须设置打分器")
+		rankOptions.SearchScorer = engine.initOptions.SearchScorer
+	}
+	if rankOptions.SearchScorer == nil {
+		return output, ErrNoScorer
+	}
+
+	// 将过滤条件的取值转换为字段类型
+	filters, err := engine.attributes.filters(request.Filters)
+	if err != nil {
+		return
+	}
+	sortFields, err := engine.attributes.sortFields(rankOptions.Sort)
+	if err != nil {
 		return
 	}
+	if request.SearchAfter != "" {
+		if rankOptions.After, err = decodeCursor(request.SearchAfter, &rankOptions); err != nil {
+			return
+		}
+	}
+	if request.IncludeDocuments && !engine.initOptions.UsePersistentStorage {
+		return output, ErrNoStorage
+	}
 
-	// 收集关键词
-	tokens := []string{}
-	if request.Text != "" {
-		querySegments := engine.segmenter.Cut([]byte(request.Text), true)
-		for _, s := range querySegments {
-			token := s.GetToken().GetText()
-			if !engine.stopTokens.IsStopToken(token) {
-				tokens = append(tokens, s.GetToken().GetText())
-			}
+	// 生成查询语法树，Text和Tokens中的关键词之间为AND关系
+	var query *Query
+	if request.Query != nil {
+		if err = validateQuery(request.Query); err != nil {
+			return
 		}
-	} else {
-		for _, t := range request.Tokens {
-			tokens = append(tokens, t)
+		query = engine.analyzeQuery(request.Query)
+	} else if request.Text != "" {
+		query = engine.analyzeQuery(textQuery(request.Text, request.Phrase, request.Slop))
+	} else if len(request.Tokens) > 0 {
+		requestTokens := normalizeTokens(request.Tokens)
+		if request.Phrase {
+			query = NewPhraseQuery(request.Slop, requestTokens...)
+		} else {
+			query = NewAndQuery(engine.expandSynonyms(requestTokens)...)
 		}
 	}
 
+	// 模糊匹配时把搜索键展开为拼写相近的搜索键
+	if query != nil && request.Fuzziness > 0 {
+		query = engine.fuzzyQuery(query, request.Fuzziness)
+	}
+
+	// 收集关键词
+	tokens := []string{}
+	if query != nil {
+		tokens = query.Terms()
+	}
+
+	// 汇总全部shard的语料统计信息，各shard用同样的idf和平均文本长度计算BM25
+	stats := engine.CorpusStats(tokens)
+
+	// 设置超时，函数返回时通知各shard停止处理
+	parent := ctx
+	if request.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx,
+			time.Nanosecond*time.Duration(NumNanosecondsInAMillisecond*request.Timeout))
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	// 建立排序器返回的通信通道
 	rankerReturnChannel := make(
 		chan rankerReturnRequest, engine.initOptions.NumShards)
 
 	// 生成查找请求
 	lookupRequest := indexerLookupRequest{
-		tokens:              tokens,
+		ctx:                 ctx,
+		query:               query,
 		labels:              request.Labels,
+		filters:             filters,
+		sortFields:          sortFields,
+		explain:             request.Explain,
+		facets:              request.Facets,
 		docIds:              request.DocIds,
+		stats:               &stats,
 		options:             rankOptions,
+		startTime:           time.Now(),
 		rankerReturnChannel: rankerReturnChannel}
 
-	// 向索引器发送查找请求
+	// 向索引器发送查找请求，查找队列已满时ctx被取消或者超时也会立即返回
 	for shard := 0; shard < engine.initOptions.NumShards; shard++ {
-		engine.indexerLookupChannels[shard] <- lookupRequest
+		select {
+		case engine.indexerLookupChannels[shard] <- lookupRequest:
+		case <-ctx.Done():
+		case <-engine.done:
+			return output, ErrEngineClosed
+		}
 	}
 
-	// 从通信通道读取排序器的输出
-	rankOutput := ScoredDocuments{}
-	timeout := request.Timeout
+	// 从通信通道读取排序器的输出，每个shard的输出都已排好序
+	rankOutputs := make([]ScoredDocuments, 0, engine.initOptions.NumShards)
+	output.Shards = make([]ShardStatus, engine.initOptions.NumShards)
+	returned := make([]bool, engine.initOptions.NumShards)
+	var facetCounts [][]map[string]int
+	collect := func(rankerOutput rankerReturnRequest) {
+		rankOutputs = append(rankOutputs, rankerOutput.docs)
+		facetCounts = append(facetCounts, rankerOutput.facetCounts)
+		returned[rankerOutput.shard] = true
+		output.Shards[rankerOutput.shard] = ShardStatus{
+			Shard:   rankerOutput.shard,
+			NumHits: rankerOutput.numDocs,
+			Elapsed: rankerOutput.elapsed,
+		}
+		output.TotalHits += rankerOutput.numDocs
+	}
 	isTimeout := false
-	if timeout <= 0 {
-		// 不设置超时
-		for shard := 0; shard < engine.initOptions.NumShards; shard++ {
-			rankerOutput := <-rankerReturnChannel
-			for _, doc := range rankerOutput.docs {
-				rankOutput = append(rankOutput, doc)
-			}
+	for shard := 0; shard < engine.initOptions.NumShards && !isTimeout; shard++ {
+		select {
+		case rankerOutput := <-rankerReturnChannel:
+			collect(rankerOutput)
+		case <-ctx.Done():
+			isTimeout = true
+			err = parent.Err()
 		}
-	} else {
-		// 设置超时
-		deadline := time.Now().Add(time.Nanosecond * time.Duration(NumNanosecondsInAMillisecond*request.Timeout))
-		for shard := 0; shard < engine.initOptions.NumShards; shard++ {
-			select {
-			case rankerOutput := <-rankerReturnChannel:
-				for _, doc := range rankerOutput.docs {
-					rankOutput = append(rankOutput, doc)
-				}
-			case <-time.After(deadline.Sub(time.Now())):
-				isTimeout = true
-				break
+	}
+
+	// 记录没有按时返回的shard
+	for shard, ok := range returned {
+		if !ok {
+			output.Shards[shard] = ShardStatus{
+				Shard:   shard,
+				Elapsed: time.Since(lookupRequest.startTime),
+				Timeout: true,
 			}
 		}
 	}
+	output.TotalHitsExact = !isTimeout
+	if len(request.Facets) > 0 {
+		output.Facets = mergeFacets(request.Facets, facetCounts)
+	}
 
-	// 再排序
-	if rankOptions.ReverseOrder {
-		sort.Sort(sort.Reverse(rankOutput))
-	} else {
-		sort.Sort(rankOutput)
+	// 多路归并，只取出本页需要的文档
+	limit := 0
+	if rankOptions.MaxOutputs != 0 {
+		limit = rankOptions.OutputOffset + rankOptions.MaxOutputs
 	}
+	rankOutput := mergeScoredDocuments(rankOutputs, &rankOptions, limit)
 
 	// 准备输出
 	output.Tokens = tokens
-	var start, end int
-	if rankOptions.MaxOutputs == 0 {
-		start = utils.MinInt(rankOptions.OutputOffset, len(rankOutput))
-		end = len(rankOutput)
-	} else {
-		start = utils.MinInt(rankOptions.OutputOffset, len(rankOutput))
-		end = utils.MinInt(start+rankOptions.MaxOutputs, len(rankOutput))
+	start := utils.MinInt(rankOptions.OutputOffset, len(rankOutput))
+	output.Docs = rankOutput[start:]
+	if len(output.Docs) > 0 {
+		output.Cursor = encodeCursor(&output.Docs[len(output.Docs)-1], &rankOptions)
+	}
+	if request.Highlight != nil && engine.contents != nil {
+		for i := range output.Docs {
+			doc := &output.Docs[i]
+			if content, found := engine.contents[engine.getShard(doc.DocId)].get(doc.DocId); found {
+				doc.Fragments = Highlight(content, tokens, doc, *request.Highlight)
+			}
+		}
+	}
+	if request.IncludeDocuments && err == nil {
+		err = engine.fillDocuments(output.Docs)
+	}
+	if output.TotalHits == 0 && !isTimeout && err == nil {
+		output.Suggestions = engine.suggestQueries(engine.correctionTokens(&request))
 	}
-	output.Docs = rankOutput[start:end]
 	output.Timeout = isTimeout
 	return
 }
 
-func (engine *Engine) indexerAddDocumentWorker(shard int) {
-	for {
-		request := <-engine.indexerAddDocumentChannels[shard]
-		engine.indexers[shard].AddDocument(request.document)
-		atomic.AddUint64(&engine.numTokenIndexAdded,
-			uint64(len(request.document.Keywords)))
-		atomic.AddUint64(&engine.numDocumentsIndexed, 1)
+// 多路归并各shard已排好序的输出，返回排在最前面的limit个文档，limit为0时返回全部
+func mergeScoredDocuments(outputs []ScoredDocuments, options *RankOptions, limit int) ScoredDocuments {
+	total := 0
+	cursors := &scoredDocumentCursors{options: options}
+	for _, docs := range outputs {
+		if len(docs) > 0 {
+			total += len(docs)
+			cursors.cursors = append(cursors.cursors, scoredDocumentCursor{docs: docs})
+		}
+	}
+	if limit == 0 || limit > total {
+		limit = total
+	}
+	heap.Init(cursors)
+
+	merged := make(ScoredDocuments, 0, limit)
+	for len(merged) < limit {
+		cursor := &cursors.cursors[0]
+		merged = append(merged, cursor.docs[cursor.index])
+		cursor.index++
+		if cursor.index == len(cursor.docs) {
+			heap.Pop(cursors)
+		} else {
+			heap.Fix(cursors, 0)
+		}
 	}
+	return merged
 }
 
-func (engine *Engine) indexerLookupWorker(shard int) {
+// 归并时指向一个shard输出中下一个文档的游标
+type scoredDocumentCursor struct {
+	docs  ScoredDocuments
+	index int
+}
+
+// 游标的堆，堆顶是下一个应该输出的文档
+type scoredDocumentCursors struct {
+	cursors []scoredDocumentCursor
+	options *RankOptions
+}
+
+func (h *scoredDocumentCursors) Len() int {
+	return len(h.cursors)
+}
+func (h *scoredDocumentCursors) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+func (h *scoredDocumentCursors) Less(i, j int) bool {
+	a := &h.cursors[i].docs[h.cursors[i].index]
+	b := &h.cursors[j].docs[h.cursors[j].index]
+	return h.options.Before(a, b)
+}
+func (h *scoredDocumentCursors) Push(x interface{}) {
+	h.cursors = append(h.cursors, x.(scoredDocumentCursor))
+}
+func (h *scoredDocumentCursors) Pop() interface{} {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
+
+// 对搜索键分词并去掉停用词和空白，没有设置分词器时归一化后按空白切分
+// 使用普通模式分词：搜索模式会略去覆盖整段文本的分词，一个搜索键恰好是词典中的
+// 一个词时（比如"华为"）只剩下单字，无法匹配文档中的该词
+func (engine *Engine) tokenize(text string) (tokens []string) {
+	if engine.segmenter == nil {
+		for _, token := range strings.Fields(NormalizeText(text)) {
+			if !engine.stopTokens.IsStopToken(token) {
+				tokens = append(tokens, token)
+			}
+		}
+		return
+	}
+	for _, s := range engine.segmenter.Cut([]byte(text), false) {
+		token := s.GetToken().GetText()
+		if strings.TrimSpace(token) != "" && !engine.stopTokens.IsStopToken(token) {
+			tokens = append(tokens, token)
+		}
+	}
+	return
+}
+
+// 对查询语法树中的搜索键分词
+// 一个搜索键分出多个关键词时替换为这些关键词的AND查询，只包含停用词的搜索键
+// 从语法树中删除，有同义词的关键词替换为同义词组，短语中的关键词不展开。
+// 启用拼音搜索时的分词见tokenizePinyin。
+// 整个查询为空时返回nil
+func (engine *Engine) analyzeQuery(query *Query) *Query {
+	switch query.Type {
+	case TermQuery:
+		tokens := engine.tokenizePinyin(query.Term)
+		if len(tokens) == 0 {
+			return nil
+		}
+		children := engine.expandSynonyms(tokens)
+		analyzed := children[0]
+		if len(children) > 1 {
+			analyzed = NewAndQuery(children...)
+		}
+		boostTerms(analyzed, query.Boost)
+		return analyzed
+	case NotQuery:
+		child := engine.analyzeQuery(query.Children[0])
+		if child == nil {
+			return nil
+		}
+		return NewNotQuery(child)
+	case PhraseQuery:
+		// 短语中每个搜索键分词后按顺序拼接
+		var tokens []string
+		for _, child := range query.Children {
+			tokens = append(tokens, engine.tokenize(child.Term)...)
+		}
+		if len(tokens) == 0 {
+			return nil
+		} else if len(tokens) == 1 {
+			return NewTermQuery(tokens[0])
+		}
+		return NewPhraseQuery(query.Slop, tokens...)
+	}
+
+	var children []*Query
+	for _, child := range query.Children {
+		if analyzed := engine.analyzeQuery(child); analyzed != nil {
+			children = append(children, analyzed)
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	} else if len(children) == 1 && children[0].Type != NotQuery {
+		return children[0]
+	}
+	return &Query{Type: query.Type, Children: children}
+}
+
+// 把搜索键的权重赋给它分词和展开同义词后得到的每个搜索键，boost为0时不做修改
+func boostTerms(query *Query, boost float32) {
+	if boost == 0 {
+		return
+	}
+	if query.Type == TermQuery {
+		query.Boost = boost
+		return
+	}
+	for _, child := range query.Children {
+		boostTerms(child, boost)
+	}
+}
+
+// 将搜索短语转换为尚未分词的查询语法树
+// phrase为true时整个短语作为短语查询，否则双引号括起的部分作为短语查询，
+// 其余部分之间为AND关系
+func textQuery(text string, phrase bool, slop int) *Query {
+	if phrase {
+		return NewPhraseQuery(slop, text)
+	}
+	var children []*Query
+	for i, part := range strings.Split(text, "\"") {
+		if i%2 == 1 {
+			children = append(children, NewPhraseQuery(slop, part))
+		} else {
+			children = append(children, NewTermQuery(part))
+		}
+	}
+	return NewAndQuery(children...)
+}
+
+func (engine *Engine) indexerWorker(shard int) {
 	for {
-		request := <-engine.indexerLookupChannels[shard]
+		var ordered indexerRequest
+		select {
+		case ordered = <-engine.indexerChannels[shard]:
+		case <-engine.done:
+			return
+		}
+		if ordered.remove != nil {
+			engine.removeFromShard(shard, ordered.remove)
+			continue
+		}
 
-		var docs []IndexedDocument
-		if len(request.docIds) == 0 {
-			docs = engine.indexers[shard].Lookup(request.tokens, request.labels, nil)
+		// 等待这个请求分词完成，之后的请求即使已经分好词也要排在它后面
+		var request indexerAddDocumentRequest
+		select {
+		case request = <-ordered.segmented:
+		case <-engine.done:
+			return
+		}
+		version := &engine.shardVersions[shard]
+		version.Lock()
+		if len(request.documents) == 1 {
+			engine.indexers[shard].AddDocument(request.documents[0])
 		} else {
-			//通过request.docIds 生成查询字典
-			if (len(request.docIds) != 2) || (request.docIds[0] > request.docIds[1]) {
-				continue
+			engine.indexers[shard].AddDocuments(request.documents)
+		}
+		for i, document := range request.documents {
+			engine.rankers[shard].AddScoringFields(document.DocId, request.fields[i])
+		}
+		version.version++
+		version.Unlock()
+
+		for i, document := range request.documents {
+			if engine.contents != nil {
+				engine.contents[shard].set(document.DocId, request.contents[i])
 			}
-			/*
-				docIds := make(map[uint64]bool, request.docIds[1]-request.docIds[0]+1)
-				//这个过程比较浪费时间
-				log.Println("map", shard, time.Now().UnixNano())
-				for i := request.docIds[0]; i <= request.docIds[1]; i++ {
-					docIds[i] = true
-				}
-				log.Println("map", shard, time.Now().UnixNano())
-			*/
-			/*
-				for _, ids := range request.docIds {
-					docIds[ids] = true
-				}
-			*/
-			//将上方代码注释，此处无需生成字典，继续传递docids的范围
-			//就行，然后只要判断最终搜索出来的结果在不在这个范围内就OK
-			/*
-				docs = engine.indexers[shard].Lookup(request.tokens, request.labels, &docIds)
-			*/
-			docs = engine.indexers[shard].Lookup(request.tokens, request.labels, request.docIds)
+			if engine.suggester != nil {
+				engine.suggester.setDocument(document.DocId, suggestTerms(document))
+			}
+			atomic.AddUint64(&engine.numTokenIndexAdded,
+				uint64(len(document.Keywords)))
+		}
+		atomic.AddUint64(&engine.numDocumentsIndexed, uint64(len(request.documents)))
+		if request.ticket != nil {
+			close(request.ticket.done)
+		}
+		if request.batch != nil {
+			request.batch.finish(request.positions, nil)
+		}
+		engine.pending.done(request.epoch)
+	}
+}
+
+// 从shard中删除文档的反向索引项、评分字段和其他数据
+func (engine *Engine) removeFromShard(shard int, request *indexerRemoveDocumentRequest) {
+	version := &engine.shardVersions[shard]
+	version.Lock()
+	engine.indexers[shard].RemoveDocument(request.docId)
+	engine.rankers[shard].RemoveScoringFields(request.docId)
+	version.version++
+	version.Unlock()
+	if engine.contents != nil {
+		engine.contents[shard].remove(request.docId)
+	}
+	if engine.suggester != nil {
+		engine.suggester.removeDocument(request.docId)
+	}
+	engine.pending.done(request.epoch)
+}
+
+func (engine *Engine) indexerLookupWorker(shard int) {
+	for {
+		var request indexerLookupRequest
+		select {
+		case request = <-engine.indexerLookupChannels[shard]:
+		case <-engine.done:
+			return
+		}
+		// 搜索已经结束时不再查找
+		if request.ctx.Err() != nil {
+			continue
+		}
+
+		version := &engine.shardVersions[shard]
+		version.RLock()
+		stamp := version.version
+		docs, facetCounts, valid := engine.lookupShard(shard, &request)
+		version.RUnlock()
+		if !valid {
+			request.rankerReturnChannel <- rankerReturnRequest{
+				shard: shard, elapsed: time.Since(request.startTime)}
+			continue
+		}
+
+		if request.ctx.Err() != nil {
+			continue
 		}
 
 		if len(docs) == 0 {
-			request.rankerReturnChannel <- rankerReturnRequest{}
+			request.rankerReturnChannel <- rankerReturnRequest{
+				shard: shard, elapsed: time.Since(request.startTime), facetCounts: facetCounts}
 			continue
 		}
 
 		rankerRequest := rankerRankRequest{
+			ctx:                 request.ctx,
 			docs:                docs,
+			facetCounts:         facetCounts,
+			lookup:              &request,
+			version:             stamp,
 			options:             request.options,
+			startTime:           request.startTime,
 			rankerReturnChannel: request.rankerReturnChannel}
-		engine.rankerRankChannels[shard] <- rankerRequest
+		// 已经放弃的搜索可能在引擎关闭时仍有请求未排序，此时不再等待排序器
+		select {
+		case engine.rankerRankChannels[shard] <- rankerRequest:
+		case <-engine.done:
+			return
+		}
+	}
+}
+
+// 在shard中查找文档并做分面统计，调用者必须持有shard的读锁
+// request.docIds不是有效的范围时valid为false
+func (engine *Engine) lookupShard(shard int, request *indexerLookupRequest) (
+	docs []IndexedDocument, facetCounts []map[string]int, valid bool) {
+	if len(request.docIds) == 0 {
+		docs = engine.indexers[shard].LookupQuery(request.ctx, LookupRequest{
+			Query: request.query, Labels: request.labels, Filters: request.filters,
+			SortFields: request.sortFields, Explain: request.explain, Stats: request.stats,
+			WithLabels: len(request.facets) > 0})
+	} else {
+		//通过request.docIds 生成查询字典
+		if (len(request.docIds) != 2) || (request.docIds[0] > request.docIds[1]) {
+			return nil, nil, false
+		}
+		/*
+			docIds := make(map[uint64]bool, request.docIds[1]-request.docIds[0]+1)
+			//这个过程比较浪费时间
+			log.Println("map", shard, time.Now().UnixNano())
+			for i := request.docIds[0]; i <= request.docIds[1]; i++ {
+				docIds[i] = true
+			}
+			log.Println("map", shard, time.Now().UnixNano())
+		*/
+		/*
+			for _, ids := range request.docIds {
+				docIds[ids] = true
+			}
+		*/
+		//将上方代码注释，此处无需生成字典，继续传递docids的范围
+		//就行，然后只要判断最终搜索出来的结果在不在这个范围内就OK
+		/*
+			docs = engine.indexers[shard].Lookup(request.tokens, request.labels, &docIds)
+		*/
+		docs = engine.indexers[shard].LookupQuery(request.ctx, LookupRequest{
+			Query: request.query, Labels: request.labels, Filters: request.filters,
+			SortFields: request.sortFields, Explain: request.explain, DocIds: request.docIds,
+			Stats: request.stats, WithLabels: len(request.facets) > 0})
+	}
+
+	// 对查找到的全部文档做分面统计
+	if len(request.facets) > 0 {
+		facetCounts = make([]map[string]int, len(request.facets))
+		for i := range facetCounts {
+			facetCounts[i] = make(map[string]int)
+		}
+		for _, doc := range docs {
+			countFacets(request.facets, doc.Labels, facetCounts)
+		}
 	}
+	return docs, facetCounts, true
 }
 
 func (engine *Engine) persistentStorageIndexDocumentWorker(shard int) {
 	for {
-		request := <-engine.persistentStorageIndexDocumentChannels[shard]
-
-		// 得到key
-		b := make([]byte, 10)
-		length := binary.PutUvarint(b, request.docId)
-
-		// 得到value
-		var buf bytes.Buffer
-		enc := gob.NewEncoder(&buf)
-		err := enc.Encode(request.data)
-		if err != nil {
-			atomic.AddUint64(&engine.numDocumentsStored, 1)
+		var request persistentStorageIndexDocumentRequest
+		select {
+		case request = <-engine.persistentStorageIndexDocumentChannels[shard]:
+		case <-engine.done:
+			return
+		}
+		if request.remove {
+			engine.persistentStorageRemoveDocuments(shard, request)
 			continue
 		}
 
-		// 将key-value写入数据库
-		engine.searchpipline.Set(shard, b[0:length], buf.Bytes())
-		atomic.AddUint64(&engine.numDocumentsStored, 1)
+		// 得到key和value，无法编码的文档不写入数据库
+		keys := make([][]byte, 0, len(request.docs))
+		values := make([][]byte, 0, len(request.docs))
+		var positions []int
+		for i, doc := range request.docs {
+			var buf bytes.Buffer
+			enc := gob.NewEncoder(&buf)
+			if err := enc.Encode(doc.Data); err != nil {
+				if request.batch != nil {
+					request.batch.finish(request.positions[i:i+1],
+						fmt.Errorf("%w: 无法编码文档: %v", ErrStorage, err))
+				}
+				continue
+			}
+			keys = append(keys, docIdKey(doc.DocId))
+			values = append(values, buf.Bytes())
+			if request.batch != nil {
+				positions = append(positions, request.positions[i])
+			}
+		}
+
+		// 将key-value写入数据库，写入失败时文档仍保留在内存索引中
+		var err error
+		if request.batch == nil {
+			if len(keys) == 1 {
+				err = engine.searchpipline.Set(shard, keys[0], values[0])
+			}
+			if err != nil {
+				log.Println(err)
+			}
+		} else {
+			if len(keys) > 0 {
+				err = engine.searchpipline.SetBatch(shard, keys, values)
+			}
+			request.batch.finish(positions, err)
+		}
+		engine.pending.done(request.epoch)
 	}
 }
 
-func (engine *Engine) persistentStorageRemoveDocumentWorker(docId uint64, shard uint32) {
-	// 得到key
-	b := make([]byte, 10)
-	length := binary.PutUvarint(b, docId)
-
-	s := int(shard)
-	// 从数据库删除该key
-	engine.searchpipline.Delete(s, b[0:length])
+func (engine *Engine) persistentStorageRemoveDocuments(shard int, request persistentStorageIndexDocumentRequest) {
+	// 从数据库删除这些key
+	for _, doc := range request.docs {
+		if err := engine.searchpipline.Delete(shard, docIdKey(doc.DocId)); err != nil {
+			log.Println(err)
+		}
+	}
+	engine.pending.done(request.epoch)
 }
 
 func (engine *Engine) persistentStorageInitWorker(shard int) {
 	err := engine.searchpipline.Recover(shard, engine.internalIndexDocument)
 	if err == io.EOF {
-		engine.persistentStorageInitChannel <- true
-		return
-	} else if err != nil {
-		engine.persistentStorageInitChannel <- true
-		log.Fatal("无法遍历数据库")
+		err = nil
+	} else if err != nil && !errors.Is(err, ErrStorage) {
+		err = fmt.Errorf("%w: 无法遍历数据库: %v", ErrStorage, err)
+	}
+	engine.persistentStorageInitChannel <- err
+}
+
+// 汇总全部shard的语料统计信息
+// 返回的DocumentFrequencies包含terms中每个搜索键在全部文档中出现的文档数
+func (engine *Engine) CorpusStats(terms []string) CorpusStats {
+	stats := CorpusStats{DocumentFrequencies: make(map[string]uint64, len(terms))}
+	for _, indexer := range engine.indexers {
+		stats.Merge(indexer.CorpusStats(terms))
 	}
-	engine.persistentStorageInitChannel <- true
+	return stats
 }
 
 func (engine *Engine) NumTokenIndexAdded() uint64 {
-	return engine.numTokenIndexAdded
+	return atomic.LoadUint64(&engine.numTokenIndexAdded)
 }
 
 func (engine *Engine) NumDocumentsIndexed() uint64 {
-	return engine.numDocumentsIndexed
+	return atomic.LoadUint64(&engine.numDocumentsIndexed)
 }
 
 // 关闭引擎
-func (engine *Engine) Close() {
-	engine.FlushIndex()
-	if engine.initOptions.UsePersistentStorage {
-		storageshards := engine.searchpipline.GetStorageShards()
-		for shard := 0; shard < storageshards; shard++ {
-			engine.searchpipline.Close(shard)
-		}
+//
+// 关闭后IndexDocument、RemoveDocument和Search返回ErrEngineClosed。Close先等待
+// 正在执行的调用返回，并等待已提交的文档完成索引和持久化，然后停止全部工作
+// 协程并关闭存储。可以重复调用，之后的调用直接返回nil。
+// 返回的错误来自关闭存储。
+func (engine *Engine) Close() (err error) {
+	if !engine.initialized {
+		return nil
 	}
+	engine.closeOnce.Do(func() {
+		// 拒绝新的请求
+		engine.closeLock.Lock()
+		engine.closed = true
+		engine.closeLock.Unlock()
+
+		// 等待已登记的请求和异步的索引完成
+		engine.requests.Wait()
+		engine.FlushIndex()
+
+		// 停止工作协程
+		close(engine.done)
+		engine.workers.Wait()
+
+		if engine.initOptions.UsePersistentStorage {
+			storageshards := engine.searchpipline.GetStorageShards()
+			for shard := 0; shard < storageshards; shard++ {
+				if closeErr := engine.searchpipline.Close(shard); closeErr != nil && err == nil {
+					err = closeErr
+				}
+			}
+		}
+	})
+	return
 }
 
-// 从文本hash得到要分配到的shard
-func (engine *Engine) getShard(hash uint32) int {
-	return int(hash - hash/uint32(engine.initOptions.NumShards)*uint32(engine.initOptions.NumShards))
+// 从docId得到要分配到的索引器/排序器shard
+// 只用docId计算hash，保证同一文档的每次索引、删除都落在同一个shard上
+func (engine *Engine) getShard(docId uint64) int {
+	hash := utils.Murmur3(docIdKey(docId))
+	return int(hash % uint32(engine.initOptions.NumShards))
+}
+
+// 从docId得到持久化存储的shard
+// 沿用旧版本的hash输入（"%d"加上十进制的docId），旧版本写入的数据不需要迁移，
+// 删除和覆盖仍能落在原来的存储shard上
+func (engine *Engine) getStorageShard(docId uint64) int {
+	hash := utils.Murmur3([]byte("%d" + strconv.FormatUint(docId, 10)))
+	return int(hash % uint32(engine.searchpipline.GetStorageShards()))
+}
+
+// 文档在持久化存储中的key，也用于计算shard
+func docIdKey(docId uint64) []byte {
+	b := make([]byte, binary.MaxVarintLen64)
+	length := binary.PutUvarint(b, docId)
+	return b[:length]
 }
 
 //停用词管理
@@ -821,26 +1743,31 @@ type StopTokens struct {
 
 // 从stopTokenFile中读入停用词，一个词一行
 // 文档索引建立时会跳过这些停用词
-func (st *StopTokens) Init(stopTokenFile string) {
+// 文件无法读取时返回包装了ErrLoadStopTokens的错误
+func (st *StopTokens) Init(stopTokenFile string) error {
 	st.stopTokens = make(map[string]bool)
 	if stopTokenFile == "" {
-		return
+		return nil
 	}
 
 	file, err := os.Open(stopTokenFile)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("%w \"%s\": %v", ErrLoadStopTokens, stopTokenFile, err)
 	}
 	defer file.Close()
 
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		text := scanner.Text()
+		// 停用词与关键词一样做归一化
+		text := NormalizeText(scanner.Text())
 		if text != "" {
 			st.stopTokens[text] = true
 		}
 	}
-
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w \"%s\": %v", ErrLoadStopTokens, stopTokenFile, err)
+	}
+	return nil
 }
 
 func (st *StopTokens) IsStopToken(token string) bool {
@@ -0,0 +1,679 @@
+package search_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aosen/search"
+	"github.com/aosen/search/indexer"
+	"github.com/aosen/search/ranker"
+	"github.com/aosen/search/scorer"
+	"github.com/aosen/search/segmenter"
+	"github.com/aosen/search/utils"
+)
+
+// 测试用的分词词典
+const testDictionary = `中国 100 ns
+北京 100 ns
+华为 100 nz
+手机 100 n
+电话 80 n
+二手 50 n
+价格 50 n
+发布 50 v
+电脑 80 n
+计算机 80 n
+苹果 80 n
+`
+
+// 载入测试词典的分词器
+func newTestSegmenter(t *testing.T) search.SearchSegmenter {
+	file := filepath.Join(t.TempDir(), "dictionary.txt")
+	if err := os.WriteFile(file, []byte(testDictionary), 0644); err != nil {
+		t.Fatal(err)
+	}
+	seg, err := segmenter.InitChinaCut(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return seg
+}
+
+// 生成测试用的引擎，options中没有设置的索引器、排序器和打分器使用内置实现
+func newTestEngine(t *testing.T, options search.EngineInitOptions) *search.Engine {
+	if options.CreateIndexer == nil {
+		options.CreateIndexer = func() search.SearchIndexer { return indexer.NewWuKongIndexer() }
+	}
+	if options.CreateRanker == nil {
+		options.CreateRanker = func() search.SearchRanker { return ranker.NewWuKongRanker() }
+	}
+	if options.SearchScorer == nil {
+		options.SearchScorer = scorer.NewBM25Scorer()
+	}
+	if options.IndexerInitOptions == nil {
+		options.IndexerInitOptions = &search.IndexerInitOptions{IndexType: search.LocationsIndex}
+	}
+	engine := search.NewSearchEngine()
+	if err := engine.Init(options); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { engine.Close() })
+	return engine
+}
+
+// 按顺序生成关键词，每个关键词占10个字节
+func testTokens(texts ...string) []search.TokenData {
+	tokens := make([]search.TokenData, len(texts))
+	for i, text := range texts {
+		tokens[i] = search.TokenData{Text: text, Locations: []int{i * 10}}
+	}
+	return tokens
+}
+
+// 返回搜索结果中的文档编号
+func searchDocIds(t *testing.T, engine *search.Engine, request search.SearchRequest) []uint64 {
+	response, err := engine.Search(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docIds := make([]uint64, len(response.Docs))
+	for i, doc := range response.Docs {
+		docIds[i] = doc.DocId
+	}
+	return docIds
+}
+
+func TestSearchParsedQuery(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2, Segmenter: newTestSegmenter(t)})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "华为手机在北京"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "华为电话"})
+	engine.IndexDocument(3, search.DocumentIndexData{Content: "二手华为手机"})
+	engine.IndexDocument(4, search.DocumentIndexData{Content: "中国电话"})
+	engine.FlushIndex()
+
+	query, err := search.ParseQuery("(手机 OR 电话) AND 华为 -二手")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := engine.Search(search.SearchRequest{Query: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(response.Tokens) != "[手机 电话 华为]" {
+		t.Errorf("关键词为%v", response.Tokens)
+	}
+	docIds := make(map[uint64]bool)
+	for _, doc := range response.Docs {
+		docIds[doc.DocId] = true
+	}
+	if len(docIds) != 2 || !docIds[1] || !docIds[2] {
+		t.Errorf("命中%v，应为文档1和2", response.Docs)
+	}
+}
+
+func TestSearchText(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2, Segmenter: newTestSegmenter(t)})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "华为手机在北京"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "北京的手机价格"})
+	engine.FlushIndex()
+
+	for text, want := range map[string]int{
+		"华为": 1, "手机": 2, "华为手机": 1, "北京 手机": 2, "手机价格": 1, `"华为手机"`: 1, `"手机华为"`: 0,
+	} {
+		if docIds := searchDocIds(t, engine, search.SearchRequest{Text: text}); len(docIds) != want {
+			t.Errorf("%s: 命中%v，应有%d个文档", text, docIds, want)
+		}
+	}
+	response, _ := engine.Search(search.SearchRequest{Text: "华为手机"})
+	if fmt.Sprint(response.Tokens) != "[华为 手机]" {
+		t.Errorf("关键词为%v", response.Tokens)
+	}
+}
+
+// 查找时阻塞到release被关闭的索引器
+type blockingIndexer struct {
+	*indexer.WuKongIndexer
+	release chan struct{}
+}
+
+func (self *blockingIndexer) LookupQuery(ctx context.Context, request search.LookupRequest) []search.IndexedDocument {
+	<-self.release
+	return self.WuKongIndexer.LookupQuery(ctx, request)
+}
+
+func TestSearchContextWithFullLookupQueue(t *testing.T) {
+	release := make(chan struct{})
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards:                 1,
+		IndexerBufferLength:       1,
+		NumIndexerThreadsPerShard: 1,
+		CreateIndexer: func() search.SearchIndexer {
+			return &blockingIndexer{WuKongIndexer: indexer.NewWuKongIndexer(), release: release}
+		},
+	})
+	defer close(release)
+
+	// 第一个请求占住查找协程，第二个请求填满查找队列
+	for i := 0; i < 2; i++ {
+		engine.Search(search.SearchRequest{Tokens: []string{"a"}, Timeout: 20})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	finished := make(chan error, 1)
+	go func() {
+		_, err := engine.SearchContext(ctx, search.SearchRequest{Tokens: []string{"a"}})
+		finished <- err
+	}()
+	select {
+	case err := <-finished:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("返回%v，应为context.DeadlineExceeded", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("查找队列已满时超时的搜索没有返回")
+	}
+}
+
+func TestUpdateDocumentKeepsLastVersion(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2, NumSegmenterThreads: 8})
+	for round := 0; round < 50; round++ {
+		for version := 0; version < 10; version++ {
+			engine.UpdateDocument(1, search.DocumentIndexData{
+				Tokens: testTokens(fmt.Sprintf("v%d", version))})
+		}
+		engine.FlushIndex()
+		if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"v9"}}); len(docIds) != 1 {
+			t.Fatalf("第%d轮: 最后的版本没有生效", round)
+		}
+		for version := 0; version < 9; version++ {
+			token := fmt.Sprintf("v%d", version)
+			if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{token}}); len(docIds) != 0 {
+				t.Fatalf("第%d轮: 旧版本%s仍在索引中", round, token)
+			}
+		}
+	}
+}
+
+// 记录评分字段与命中的关键词不属于同一版本的次数
+// 被替换的文档的版本v命中关键词v，评分字段也是v，其他文档没有评分字段
+type versionCheckScorer struct {
+	token      string
+	mismatches *int32
+}
+
+func (self versionCheckScorer) Score(doc search.IndexedDocument, fields interface{}) []float32 {
+	if fields != nil && fields != self.token {
+		atomic.AddInt32(self.mismatches, 1)
+	}
+	// 让出CPU，使索引协程有机会在查找和打分之间替换文档
+	runtime.Gosched()
+	return []float32{1}
+}
+
+func TestUpdateDocumentConcurrentSearch(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 1, NumIndexerThreadsPerShard: 4})
+	// 不变的文档排在被替换的文档前面，打分时替换有足够的时间生效
+	for docId := uint64(1); docId < 100; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: testTokens("a", "b")})
+	}
+	engine.IndexDocument(100, search.DocumentIndexData{Tokens: testTokens("a"), Fields: "a"})
+	engine.FlushIndex()
+
+	var mismatches, searches int32
+	var wait sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		token := []string{"a", "b"}[i%2]
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			scorer := versionCheckScorer{token: token, mismatches: &mismatches}
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				engine.Search(search.SearchRequest{
+					Tokens: []string{token}, RankOptions: &search.RankOptions{SearchScorer: scorer}})
+				atomic.AddInt32(&searches, 1)
+			}
+		}()
+	}
+	for i := 0; atomic.LoadInt32(&searches) < 200; i++ {
+		token := []string{"b", "a"}[i%2]
+		engine.UpdateDocument(100, search.DocumentIndexData{Tokens: testTokens(token), Fields: token})
+		runtime.Gosched()
+	}
+	engine.FlushIndex()
+	close(stop)
+	wait.Wait()
+	if mismatches != 0 {
+		t.Errorf("%d次用其他版本的评分字段打分", mismatches)
+	}
+}
+
+func TestRemoveDocumentAfterIndexDocument(t *testing.T) {
+	pipeline := newTestPipeline(2)
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, NumSegmenterThreads: 8, UsePersistentStorage: true, SearchPipline: pipeline})
+	for docId := uint64(0); docId < 200; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: testTokens("a")})
+		engine.RemoveDocument(docId)
+	}
+	engine.FlushIndex()
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"a"}}); len(docIds) != 0 {
+		t.Fatalf("删除的文档仍能搜到: %v", docIds)
+	}
+	if n := pipeline.count(); n != 0 {
+		t.Fatalf("删除的文档仍在存储中: %d", n)
+	}
+}
+
+func TestRemoveDocumentStoredByOldVersion(t *testing.T) {
+	// 按旧版本的规则把文档写入存储
+	pipeline := newTestPipeline(4)
+	for docId := uint64(0); docId < 50; docId++ {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(search.DocumentIndexData{Tokens: testTokens("a")}); err != nil {
+			t.Fatal(err)
+		}
+		key := make([]byte, binary.MaxVarintLen64)
+		length := binary.PutUvarint(key, docId)
+		shard := utils.Murmur3([]byte("%d"+strconv.FormatUint(docId, 10))) % 4
+		pipeline.Set(int(shard), key[:length], buf.Bytes())
+	}
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 2, UsePersistentStorage: true, SearchPipline: pipeline})
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Tokens: []string{"a"}}); len(docIds) != 50 {
+		t.Fatalf("恢复了%d个文档", len(docIds))
+	}
+	for docId := uint64(0); docId < 50; docId++ {
+		engine.RemoveDocument(docId)
+	}
+	engine.FlushIndex()
+	if n := pipeline.count(); n != 0 {
+		t.Fatalf("旧版本写入的文档仍在存储中: %d", n)
+	}
+}
+
+// 保存在内存中的持久化存储
+type testPipeline struct {
+	lock sync.Mutex
+	data []map[string][]byte
+}
+
+func newTestPipeline(numShards int) *testPipeline {
+	pipeline := &testPipeline{data: make([]map[string][]byte, numShards)}
+	for shard := range pipeline.data {
+		pipeline.data[shard] = make(map[string][]byte)
+	}
+	return pipeline
+}
+
+func (self *testPipeline) Init() error           { return nil }
+func (self *testPipeline) GetStorageShards() int { return len(self.data) }
+func (self *testPipeline) Conn(shard int) error  { return nil }
+func (self *testPipeline) Close(shard int) error { return nil }
+
+func (self *testPipeline) Recover(shard int, internalIndexDocument func(docId uint64, data search.DocumentIndexData)) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	for key, value := range self.data[shard] {
+		docId, _ := binary.Uvarint([]byte(key))
+		var data search.DocumentIndexData
+		if err := gob.NewDecoder(bytes.NewReader(value)).Decode(&data); err != nil {
+			return err
+		}
+		internalIndexDocument(docId, data)
+	}
+	return nil
+}
+
+func (self *testPipeline) Set(shard int, key, value []byte) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.data[shard][string(key)] = value
+	return nil
+}
+
+func (self *testPipeline) SetBatch(shard int, keys, values [][]byte) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	for i := range keys {
+		self.data[shard][string(keys[i])] = values[i]
+	}
+	return nil
+}
+
+func (self *testPipeline) Get(shard int, key []byte) ([]byte, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.data[shard][string(key)], nil
+}
+
+func (self *testPipeline) Delete(shard int, key []byte) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.data[shard], string(key))
+	return nil
+}
+
+// 存储中的文档数
+func (self *testPipeline) count() (n int) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	for _, data := range self.data {
+		n += len(data)
+	}
+	return
+}
+
+// 恢复数据失败的持久化存储，记录每个shard是否处于打开状态
+type failingPipeline struct {
+	*testPipeline
+	lock sync.Mutex
+	open []bool
+}
+
+func (self *failingPipeline) Init() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.open = make([]bool, self.GetStorageShards())
+	for shard := range self.open {
+		self.open[shard] = true
+	}
+	return nil
+}
+
+func (self *failingPipeline) Recover(shard int, internalIndexDocument func(docId uint64, data search.DocumentIndexData)) error {
+	return fmt.Errorf("%w: 无法读取", search.ErrStorage)
+}
+
+func (self *failingPipeline) Close(shard int) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.open[shard] = false
+	return nil
+}
+
+func TestInitClosesStorageOnFailure(t *testing.T) {
+	pipeline := &failingPipeline{testPipeline: newTestPipeline(3)}
+	engine := search.NewSearchEngine()
+	err := engine.Init(search.EngineInitOptions{
+		UsePersistentStorage: true,
+		SearchPipline:        pipeline,
+		CreateIndexer:        func() search.SearchIndexer { return indexer.NewWuKongIndexer() },
+		CreateRanker:         func() search.SearchRanker { return ranker.NewWuKongRanker() },
+	})
+	if !errors.Is(err, search.ErrStorage) {
+		t.Fatalf("返回%v，应为ErrStorage", err)
+	}
+	for shard, open := range pipeline.open {
+		if open {
+			t.Errorf("初始化失败后存储shard %d没有关闭", shard)
+		}
+	}
+	if _, err := engine.Search(search.SearchRequest{Tokens: []string{"a"}}); err != search.ErrEngineClosed {
+		t.Errorf("初始化失败后搜索返回%v，应为ErrEngineClosed", err)
+	}
+}
+
+// 剔除DocId为奇数的文档的打分器
+type evenDocIdScorer struct{}
+
+func (evenDocIdScorer) Score(doc search.IndexedDocument, fields interface{}) []float32 {
+	if doc.DocId%2 == 1 {
+		return nil
+	}
+	return []float32{doc.BM25}
+}
+
+func TestFacetsCountedBeforeScoring(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2})
+	for docId := uint64(0); docId < 10; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{
+			Tokens: testTokens("a"), Labels: []string{"brand:x"}})
+	}
+	engine.FlushIndex()
+	response, err := engine.Search(search.SearchRequest{
+		Tokens:      []string{"a"},
+		Facets:      []search.FacetRequest{{Prefix: "brand:"}},
+		RankOptions: &search.RankOptions{SearchScorer: evenDocIdScorer{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.TotalHits != 5 {
+		t.Errorf("TotalHits为%d，应为5", response.TotalHits)
+	}
+	if fmt.Sprint(response.Facets) != "[{brand: [{brand:x 10}]}]" {
+		t.Errorf("分面为%v，应包括被打分器剔除的文档", response.Facets)
+	}
+}
+
+func TestFacetsCountEachDocumentOnce(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 2})
+	engine.IndexDocument(1, search.DocumentIndexData{
+		Tokens: testTokens("a"), Labels: []string{"brand:x", "brand:x", "color:red"}})
+	engine.IndexDocument(2, search.DocumentIndexData{
+		Tokens: testTokens("a"), Labels: []string{"brand:y", "color:red", "color:red"}})
+	engine.IndexDocument(3, search.DocumentIndexData{Tokens: testTokens("a"), Labels: []string{"brand:y"}})
+	engine.FlushIndex()
+
+	request := search.SearchRequest{
+		Tokens: []string{"a"},
+		Facets: []search.FacetRequest{{Prefix: "brand:"}, {Prefix: "color:"}},
+	}
+	response, err := engine.Search(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(response.Facets) != "[{brand: [{brand:y 2} {brand:x 1}]} {color: [{color:red 2}]}]" {
+		t.Errorf("分面为%v", response.Facets)
+	}
+
+	// 替换文档后按新的标签计数
+	engine.UpdateDocument(3, search.DocumentIndexData{Tokens: testTokens("a"), Labels: []string{"brand:x"}})
+	engine.FlushIndex()
+	if response, err = engine.Search(request); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(response.Facets) != "[{brand: [{brand:x 2} {brand:y 1}]} {color: [{color:red 2}]}]" {
+		t.Errorf("替换后分面为%v", response.Facets)
+	}
+}
+
+// 返回搜索结果中每个文档的分数
+func searchScores(t *testing.T, engine *search.Engine, request search.SearchRequest) map[uint64]float32 {
+	response, err := engine.Search(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scores := make(map[uint64]float32, len(response.Docs))
+	for _, doc := range response.Docs {
+		scores[doc.DocId] = doc.Scores[0]
+	}
+	return scores
+}
+
+func TestScoresIndependentOfSharding(t *testing.T) {
+	// 文档长度和关键词分布都不均匀，各shard的局部统计与全局统计相差很大
+	engines := []*search.Engine{
+		newTestEngine(t, search.EngineInitOptions{NumShards: 1}),
+		newTestEngine(t, search.EngineInitOptions{NumShards: 5}),
+	}
+	for _, engine := range engines {
+		for docId := uint64(0); docId < 60; docId++ {
+			texts := []string{"common"}
+			for i := uint64(0); i < docId%7; i++ {
+				texts = append(texts, fmt.Sprintf("filler%d", i))
+			}
+			if docId%20 == 0 {
+				texts = append(texts, "rare")
+			}
+			if docId < 30 {
+				texts = append(texts, "half")
+			}
+			engine.IndexDocument(docId, search.DocumentIndexData{Tokens: testTokens(texts...)})
+		}
+		engine.FlushIndex()
+	}
+
+	for _, tokens := range [][]string{{"common"}, {"rare"}, {"half"}, {"common", "half"}} {
+		want := searchScores(t, engines[0], search.SearchRequest{Tokens: tokens})
+		got := searchScores(t, engines[1], search.SearchRequest{Tokens: tokens})
+		if len(got) != len(want) || len(want) == 0 {
+			t.Errorf("%v: 命中%d个文档，应为%d个", tokens, len(got), len(want))
+			continue
+		}
+		for docId, score := range want {
+			if diff := got[docId] - score; diff > 1e-5 || diff < -1e-5 {
+				t.Errorf("%v: 文档%d的分数为%v，单个shard时为%v", tokens, docId, got[docId], score)
+			}
+		}
+	}
+}
+
+// 分数为DocId除以7的余数，有大量相同的分数
+type docIdModScorer struct{}
+
+func (docIdModScorer) Score(doc search.IndexedDocument, fields interface{}) []float32 {
+	return []float32{float32(doc.DocId % 7)}
+}
+
+func TestPaginationMatchesFullSort(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 3})
+	const numDocs = 200
+	for docId := uint64(0); docId < numDocs; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: testTokens("a")})
+	}
+	engine.FlushIndex()
+
+	for _, reverse := range []bool{false, true} {
+		// 分数相同时按DocId排序，顺序与分数的方向相同
+		expected := make([]uint64, numDocs)
+		for i := range expected {
+			expected[i] = uint64(i)
+		}
+		sort.Slice(expected, func(i, j int) bool {
+			a, b := expected[i], expected[j]
+			if reverse {
+				a, b = b, a
+			}
+			if a%7 != b%7 {
+				return a%7 > b%7
+			}
+			return a > b
+		})
+
+		for _, maxOutputs := range []int{0, 1, 13, 50} {
+			for offset := 0; offset <= numDocs; offset += 37 {
+				docIds := searchDocIds(t, engine, search.SearchRequest{
+					Tokens: []string{"a"},
+					RankOptions: &search.RankOptions{SearchScorer: docIdModScorer{},
+						ReverseOrder: reverse, OutputOffset: offset, MaxOutputs: maxOutputs}})
+				end := numDocs
+				if maxOutputs > 0 && offset+maxOutputs < end {
+					end = offset + maxOutputs
+				}
+				want := expected[offset:end]
+				if fmt.Sprint(docIds) != fmt.Sprint(want) {
+					t.Errorf("ReverseOrder=%v OutputOffset=%d MaxOutputs=%d: 结果为%v，应为%v",
+						reverse, offset, maxOutputs, docIds, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTotalHitsWithPaging(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{NumShards: 3})
+	for docId := uint64(0); docId < 30; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: testTokens("a")})
+	}
+	engine.FlushIndex()
+
+	for _, options := range []search.RankOptions{
+		{}, {MaxOutputs: 4}, {OutputOffset: 10, MaxOutputs: 4}, {OutputOffset: 100, MaxOutputs: 4},
+	} {
+		options.SearchScorer = evenDocIdScorer{}
+		response, err := engine.Search(search.SearchRequest{Tokens: []string{"a"}, RankOptions: &options})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 被打分器剔除的奇数文档不计入命中数
+		if response.TotalHits != 15 || !response.TotalHitsExact || response.Timeout {
+			t.Errorf("%+v: TotalHits为%d，TotalHitsExact为%v", options, response.TotalHits, response.TotalHitsExact)
+		}
+		if len(response.Shards) != 3 {
+			t.Fatalf("Shards为%v", response.Shards)
+		}
+		numHits := 0
+		for shard, status := range response.Shards {
+			if status.Shard != shard || status.Timeout {
+				t.Errorf("第%d个shard的状态为%+v", shard, status)
+			}
+			numHits += status.NumHits
+		}
+		if numHits != response.TotalHits {
+			t.Errorf("各shard命中%d个文档，TotalHits为%d", numHits, response.TotalHits)
+		}
+	}
+}
+
+func TestTotalHitsWithTimedOutShard(t *testing.T) {
+	// 第一个shard的查找一直阻塞到测试结束
+	release := make(chan struct{})
+	created := 0
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 3,
+		CreateIndexer: func() search.SearchIndexer {
+			created++
+			if created == 1 {
+				return &blockingIndexer{WuKongIndexer: indexer.NewWuKongIndexer(), release: release}
+			}
+			return indexer.NewWuKongIndexer()
+		},
+	})
+	defer close(release)
+	for docId := uint64(0); docId < 30; docId++ {
+		engine.IndexDocument(docId, search.DocumentIndexData{Tokens: testTokens("a")})
+	}
+	engine.FlushIndex()
+
+	response, err := engine.Search(search.SearchRequest{Tokens: []string{"a"}, Timeout: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Timeout || response.TotalHitsExact {
+		t.Errorf("Timeout为%v，TotalHitsExact为%v", response.Timeout, response.TotalHitsExact)
+	}
+	if !response.Shards[0].Timeout {
+		t.Errorf("第一个shard的状态为%+v", response.Shards[0])
+	}
+	numHits := 0
+	for _, status := range response.Shards[1:] {
+		if status.Timeout {
+			t.Errorf("shard %d没有超时，状态为%+v", status.Shard, status)
+		}
+		numHits += status.NumHits
+	}
+	if response.TotalHits != numHits || numHits >= 30 {
+		t.Errorf("TotalHits为%d，按时返回的shard命中%d个文档", response.TotalHits, numHits)
+	}
+}
@@ -19,7 +19,8 @@ type SearchSegmenter interface {
 	// 当一个分词既出现在用户词典也出现在通用词典中，则优先使用用户词典。
 	// 词典的格式为（每个分词一行）：
 	//	分词文本 频率 词性
-	LoadDictionary(files string)
+	// 词典文件无法打开时返回包装了ErrLoadDictionary的错误
+	LoadDictionary(files string) error
 	// 对文本分词
 	// 输入参数：
 	//	bytes	UTF8文本的字节数组
@@ -191,39 +192,53 @@ func (self *Dictionary) GetMaxTokenLength() int {
 }
 
 // 将文本划分成字元
+// 字元经过归一化（见NormalizeRune），英文转为小写
 func SplitTextToWords(text Text) []Text {
+	words, _ := SplitTextToWordsWithLengths(text)
+	return words
+}
+
+// 将文本划分成字元，同时返回每个字元在原文本中的字节长度
+// 归一化可能改变字元的字节长度，比如全角字母转为半角，计算分词在原文本中的位置时
+// 需要使用原来的长度
+func SplitTextToWordsWithLengths(text Text) ([]Text, []int) {
 	output := make([]Text, 0, len(text)/8)
+	lengths := make([]int, 0, len(text)/8)
 	current := 0
-	inAlphanumeric := true
+	var alphanumeric []byte
 	alphanumericStart := 0
 	for current < len(text) {
 		r, size := utf8.DecodeRune(text[current:])
-		if size <= 2 && (unicode.IsLetter(r) || unicode.IsNumber(r)) {
+		word := text[current : current+size]
+		if normalized := NormalizeRune(r); normalized != r {
+			word = Text(string(normalized))
+			r = normalized
+		}
+		if len(word) <= 2 && (unicode.IsLetter(r) || unicode.IsNumber(r)) {
 			// 当前是拉丁字母或数字（非中日韩文字）
-			if !inAlphanumeric {
+			if len(alphanumeric) == 0 {
 				alphanumericStart = current
-				inAlphanumeric = true
 			}
+			alphanumeric = append(alphanumeric, word...)
 		} else {
-			if inAlphanumeric {
-				inAlphanumeric = false
-				if current != 0 {
-					output = append(output, toLower(text[alphanumericStart:current]))
-				}
+			if len(alphanumeric) > 0 {
+				output = append(output, toLower(alphanumeric))
+				lengths = append(lengths, current-alphanumericStart)
+				alphanumeric = nil
 			}
-			output = append(output, text[current:current+size])
+			output = append(output, word)
+			lengths = append(lengths, size)
 		}
 		current += size
 	}
 
 	// 处理最后一个字元是英文的情况
-	if inAlphanumeric {
-		if current != 0 {
-			output = append(output, toLower(text[alphanumericStart:current]))
-		}
+	if len(alphanumeric) > 0 {
+		output = append(output, toLower(alphanumeric))
+		lengths = append(lengths, current-alphanumericStart)
 	}
 
-	return output
+	return output, lengths
 }
 
 // 将英文词转化为小写
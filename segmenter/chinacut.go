@@ -24,10 +24,13 @@ type ChinaCut struct {
 	dict *search.Dictionary
 }
 
-func InitChinaCut(files string) *ChinaCut {
+// 生成分词器并载入词典，词典无法载入时返回错误
+func InitChinaCut(files string) (*ChinaCut, error) {
 	seg := &ChinaCut{}
-	seg.LoadDictionary(files)
-	return seg
+	if err := seg.LoadDictionary(files); err != nil {
+		return nil, err
+	}
+	return seg, nil
 }
 
 // 返回分词器使用的词典
@@ -35,7 +38,8 @@ func (self *ChinaCut) Dictionary() *search.Dictionary {
 	return self.dict
 }
 
-func (self *ChinaCut) segmentWords(text []search.Text, searchMode bool) []search.Segment {
+// lengths为每个字元在原文本中的字节长度，用于计算分词的位置
+func (self *ChinaCut) segmentWords(text []search.Text, lengths []int, searchMode bool) []search.Segment {
 	// 搜索模式下该分词已无继续划分可能的情况
 	if searchMode && len(text) == 1 {
 		return []search.Segment{}
@@ -92,11 +96,15 @@ func (self *ChinaCut) segmentWords(text []search.Text, searchMode bool) []search
 		index = location - 1
 	}
 
-	// 计算各个分词的字节位置
+	// 计算各个分词在原文本中的字节位置，字元归一化后长度可能改变，按原文本中的长度累加
 	bytePosition := 0
+	iWord := 0
 	for iSeg := 0; iSeg < len(outputSegments); iSeg++ {
 		outputSegments[iSeg].Start = bytePosition
-		bytePosition += search.TextSliceByteLength(outputSegments[iSeg].Token.TextList)
+		for range outputSegments[iSeg].Token.TextList {
+			bytePosition += lengths[iWord]
+			iWord++
+		}
 		outputSegments[iSeg].End = bytePosition
 	}
 	return outputSegments
@@ -108,57 +116,16 @@ func (self *ChinaCut) segmentWords(text []search.Text, searchMode bool) []search
 // 当一个分词既出现在用户词典也出现在通用词典中，则优先使用用户词典。
 // 词典的格式为（每个分词一行）：
 //	分词文本 频率 词性
-func (self *ChinaCut) LoadDictionary(files string) {
-	self.dict = new(search.Dictionary)
+// 词典文件无法打开时返回包装了search.ErrLoadDictionary的错误，原有的词典保持不变
+func (self *ChinaCut) LoadDictionary(files string) error {
+	dict := new(search.Dictionary)
 	for _, file := range strings.Split(files, ",") {
 		log.Printf("载入 %s 词典", file)
-		dictFile, err := os.Open(file)
-		defer dictFile.Close()
-		if err != nil {
-			log.Fatalf("无法载入字典文件 \"%s\" \n", file)
-		}
-
-		reader := bufio.NewReader(dictFile)
-
-		//词
-		var text string
-		//词频字符串
-		var freqText string
-		//词频int
-		var frequency int
-		//词性
-		var pos string
-
-		// 逐行读入分词
-		for {
-			size, _ := fmt.Fscanln(reader, &text, &freqText, &pos)
-			if size == 0 {
-				// 文件结束
-				break
-			} else if size < 2 {
-				// 无效行
-				continue
-			} else if size == 2 {
-				// 没有词性标注时设为空字符串
-				pos = ""
-			}
-			// 解析词频
-			var err error
-			frequency, err = strconv.Atoi(freqText)
-			if err != nil {
-				continue
-			}
-			// 过滤频率太小的词
-			if frequency < MinTokenFrequency {
-				continue
-			}
-
-			// 将分词添加到字典中
-			words := search.SplitTextToWords([]byte(text))
-			token := search.Token{TextList: words, Frequency: frequency, Pos: pos}
-			self.dict.AddToken(&token)
+		if err := loadDictionaryFile(dict, file); err != nil {
+			return err
 		}
 	}
+	self.dict = dict
 
 	// 计算每个分词的路径值，路径值含义见Token结构体的注释
 	logTotalFrequency := float32(math.Log2(float64(self.dict.TotalFrequency)))
@@ -168,7 +135,7 @@ func (self *ChinaCut) LoadDictionary(files string) {
 
 	// 对每个分词进行细致划分，用于搜索引擎模式，该模式用法见Token结构体的注释。
 	for _, token := range self.dict.Tokens {
-		segments := self.segmentWords(token.TextList, true)
+		segments := self.segmentWords(token.TextList, wordLengths(token.TextList), true)
 
 		// 计算需要添加的子分词数目
 		numTokensToAdd := 0
@@ -192,6 +159,59 @@ func (self *ChinaCut) LoadDictionary(files string) {
 	}
 
 	log.Println("词典载入完毕")
+	return nil
+}
+
+// 从一个词典文件中读入分词并加入dict
+func loadDictionaryFile(dict *search.Dictionary, file string) error {
+	dictFile, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("%w \"%s\": %v", search.ErrLoadDictionary, file, err)
+	}
+	defer dictFile.Close()
+
+	reader := bufio.NewReader(dictFile)
+
+	//词
+	var text string
+	//词频字符串
+	var freqText string
+	//词频int
+	var frequency int
+	//词性
+	var pos string
+
+	// 逐行读入分词
+	for {
+		size, _ := fmt.Fscanln(reader, &text, &freqText, &pos)
+		if size == 0 {
+			// 文件结束
+			break
+		} else if size < 2 {
+			// 无效行
+			continue
+		} else if size == 2 {
+			// 没有词性标注时设为空字符串
+			pos = ""
+		}
+		// 解析词频
+		var err error
+		frequency, err = strconv.Atoi(freqText)
+		if err != nil {
+			continue
+		}
+		// 过滤频率太小的词
+		if frequency < MinTokenFrequency {
+			continue
+		}
+
+		// 将分词添加到字典中，字元经过归一化，繁体和全角的词条与对应的简体、半角词条相同，
+		// 只保留先载入的一条及其词频，后面重复的词条被忽略
+		words := search.SplitTextToWords([]byte(text))
+		token := search.Token{TextList: words, Frequency: frequency, Pos: pos}
+		dict.AddToken(&token)
+	}
+	return nil
 }
 
 // 对文本分词
@@ -204,9 +224,18 @@ func (self *ChinaCut) Cut(bytes []byte, model bool) []search.Segment {
 	if len(bytes) == 0 {
 		return []search.Segment{}
 	}
-	// 划分字元
-	text := search.SplitTextToWords(bytes)
-	return self.segmentWords(text, model)
+	// 划分字元，字元经过繁简和全半角归一化
+	text, lengths := search.SplitTextToWordsWithLengths(bytes)
+	return self.segmentWords(text, lengths, model)
+}
+
+// 每个字元的字节长度
+func wordLengths(text []search.Text) []int {
+	lengths := make([]int, len(text))
+	for i, word := range text {
+		lengths[i] = len(word)
+	}
+	return lengths
 }
 
 // 取两整数较小值
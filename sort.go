@@ -0,0 +1,116 @@
+package search
+
+//按属性字段和相关度组合排序
+
+import "fmt"
+
+// 按相关度排序时使用的字段名，即比较打分器给出的Scores
+const SortByScore = "_score"
+
+// 缺少排序字段的文档排列的位置
+const (
+	// 排在最后，与排序方向无关
+	MissingLast = iota
+
+	// 排在最前，与排序方向无关
+	MissingFirst
+)
+
+// 一个排序条件
+type SortField struct {
+	// 属性字段名，为SortByScore时按打分器给出的分数排序
+	Field string
+
+	// 是否从大到小排序，默认从小到大
+	Descending bool
+
+	// 没有设置该字段的文档的位置，见上面的常数
+	Missing int
+}
+
+// 判断文档a是否应该排在文档b前面
+//
+// 没有设置Sort时按分数从大到小排序，ReverseOrder为true时从小到大。设置了Sort时
+// 依次比较各排序条件，ReverseOrder不起作用，全部相同时再按分数从大到小排序。
+// 最后总是按DocId从大到小排序，保证各shard和合并后的结果顺序一致。
+func (options *RankOptions) Before(a, b *ScoredDocument) bool {
+	if len(options.Sort) == 0 {
+		if options.ReverseOrder {
+			return moreScoredDocument(b, a)
+		}
+		return moreScoredDocument(a, b)
+	}
+
+	for i, field := range options.Sort {
+		var c int
+		if field.Field == SortByScore {
+			c = compareScores(a.Scores, b.Scores)
+		} else {
+			valueA, valueB := sortValue(a, i), sortValue(b, i)
+			if valueA.Valid != valueB.Valid {
+				if field.Missing == MissingFirst {
+					return !valueA.Valid
+				}
+				return valueA.Valid
+			}
+			c = compareSortValues(valueA, valueB)
+		}
+		if c != 0 {
+			if field.Descending {
+				return c > 0
+			}
+			return c < 0
+		}
+	}
+	return moreScoredDocument(a, b)
+}
+
+// 文档的第i个排序值，排序器没有给出时视为缺少该字段
+func sortValue(doc *ScoredDocument, i int) AttributeValue {
+	if i < len(doc.SortValues) {
+		return doc.SortValues[i]
+	}
+	return AttributeValue{}
+}
+
+// 比较同一字段的两个取值，每种字段类型只使用其中一个成员，其余成员为零值
+func compareSortValues(a, b AttributeValue) int {
+	switch {
+	case a.Int < b.Int:
+		return -1
+	case a.Int > b.Int:
+		return 1
+	case a.Float < b.Float:
+		return -1
+	case a.Float > b.Float:
+		return 1
+	case a.Keyword < b.Keyword:
+		return -1
+	case a.Keyword > b.Keyword:
+		return 1
+	}
+	return 0
+}
+
+// 将排序条件中的字段名转换为属性字段的下标，按相关度排序的条件为-1
+func (schema *attributeSchema) sortFields(sort []SortField) ([]int, error) {
+	if len(sort) == 0 {
+		return nil, nil
+	}
+	fields := make([]int, len(sort))
+	for i, field := range sort {
+		if field.Missing != MissingLast && field.Missing != MissingFirst {
+			return nil, fmt.Errorf("%w: 字段 \"%s\" 的Missing无效", ErrInvalidSort, field.Field)
+		}
+		if field.Field == SortByScore {
+			fields[i] = -1
+			continue
+		}
+		index, found := schema.index[field.Field]
+		if !found {
+			return nil, fmt.Errorf("%w: 未定义的属性字段 \"%s\"", ErrInvalidSort, field.Field)
+		}
+		fields[i] = index
+	}
+	return fields, nil
+}
@@ -0,0 +1,196 @@
+package search_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+// 排序测试用的文档，属性为nil表示没有设置该字段
+type sortTestDoc struct {
+	docId uint64
+	brand interface{}
+	price interface{}
+}
+
+// 取值与docIdModScorer给出的分数一致
+func (doc sortTestDoc) score() float32 {
+	return float32(doc.docId % 7)
+}
+
+func (doc sortTestDoc) value(field string) interface{} {
+	if field == "brand" {
+		return doc.brand
+	}
+	return doc.price
+}
+
+// 生成有重复取值和缺失字段的文档，分配到3个shard
+func newSortTestEngine(t *testing.T) (*search.Engine, []sortTestDoc) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards: 3,
+		Attributes: []search.AttributeField{
+			{Name: "brand", Type: search.KeywordAttribute},
+			{Name: "price", Type: search.Int64Attribute},
+		},
+	})
+	docs := make([]sortTestDoc, 60)
+	for i := range docs {
+		doc := sortTestDoc{docId: uint64(i)}
+		attributes := make(map[string]interface{})
+		if i%5 != 0 {
+			doc.brand = []string{"a", "b", "c"}[i%3]
+			attributes["brand"] = doc.brand
+		}
+		if i%7 != 0 {
+			doc.price = int64(i%4) * 10
+			attributes["price"] = doc.price
+		}
+		docs[i] = doc
+		engine.IndexDocument(doc.docId, search.DocumentIndexData{Tokens: testTokens("a"), Attributes: attributes})
+	}
+	engine.FlushIndex()
+	return engine, docs
+}
+
+// 参照实现：依次比较各排序条件，缺少字段的文档按Missing排在最前或最后，
+// 全部相同时按分数从大到小，最后按DocId从大到小
+func referenceSort(docs []sortTestDoc, fields []search.SortField) []uint64 {
+	sorted := append([]sortTestDoc(nil), docs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		for _, field := range fields {
+			var c int
+			if field.Field == search.SortByScore {
+				c = compareTestValues(a.score(), b.score())
+			} else {
+				valueA, valueB := a.value(field.Field), b.value(field.Field)
+				if (valueA == nil) != (valueB == nil) {
+					return (valueA == nil) == (field.Missing == search.MissingFirst)
+				}
+				if valueA != nil {
+					c = compareTestValues(valueA, valueB)
+				}
+			}
+			if field.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		if a.score() != b.score() {
+			return a.score() > b.score()
+		}
+		return a.docId > b.docId
+	})
+	docIds := make([]uint64, len(sorted))
+	for i, doc := range sorted {
+		docIds[i] = doc.docId
+	}
+	return docIds
+}
+
+func compareTestValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case string:
+		if b := b.(string); a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+	case int64:
+		if b := b.(int64); a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+	case float32:
+		if b := b.(float32); a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func sortedSearch(t *testing.T, engine *search.Engine, fields []search.SortField, offset, maxOutputs int) []uint64 {
+	return searchDocIds(t, engine, search.SearchRequest{
+		Tokens: []string{"a"},
+		RankOptions: &search.RankOptions{
+			SearchScorer: docIdModScorer{},
+			Sort:         fields,
+			OutputOffset: offset,
+			MaxOutputs:   maxOutputs,
+		},
+	})
+}
+
+func TestSortMatchesReference(t *testing.T) {
+	engine, docs := newSortTestEngine(t)
+	for _, fields := range [][]search.SortField{
+		{{Field: "brand"}, {Field: "price", Descending: true}},
+		{{Field: "price", Missing: search.MissingFirst}, {Field: "brand", Descending: true, Missing: search.MissingFirst}},
+		{{Field: "brand", Descending: true, Missing: search.MissingFirst}, {Field: search.SortByScore}},
+		{{Field: "price"}, {Field: search.SortByScore, Descending: true}, {Field: "brand"}},
+		{{Field: search.SortByScore, Descending: true}},
+		{{Field: search.SortByScore}},
+	} {
+		expected := fmt.Sprint(referenceSort(docs, fields))
+		if docIds := fmt.Sprint(sortedSearch(t, engine, fields, 0, 0)); docIds != expected {
+			t.Errorf("按%v排序为\n%s\n应为\n%s", fields, docIds, expected)
+		}
+
+		// 逐页取出的结果拼接后与完整排序一致
+		var pages []uint64
+		for offset := 0; offset < len(docs); offset += 7 {
+			pages = append(pages, sortedSearch(t, engine, fields, offset, 7)...)
+		}
+		if docIds := fmt.Sprint(pages); docIds != expected {
+			t.Errorf("按%v分页排序为\n%s\n应为\n%s", fields, docIds, expected)
+		}
+	}
+}
+
+func TestSortByScoreDescendingMatchesDefault(t *testing.T) {
+	engine, _ := newSortTestEngine(t)
+	byScore := sortedSearch(t, engine, []search.SortField{{Field: search.SortByScore, Descending: true}}, 0, 0)
+	if docIds := sortedSearch(t, engine, nil, 0, 0); fmt.Sprint(docIds) != fmt.Sprint(byScore) {
+		t.Errorf("不设置Sort时为%v，应与按分数从大到小排序相同%v", docIds, byScore)
+	}
+}
+
+func TestSortMissing(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		NumShards:  2,
+		Attributes: []search.AttributeField{{Name: "price", Type: search.Int64Attribute}},
+	})
+	engine.IndexDocument(1, search.DocumentIndexData{Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": 10}})
+	engine.IndexDocument(2, search.DocumentIndexData{Tokens: testTokens("a")})
+	engine.IndexDocument(3, search.DocumentIndexData{Tokens: testTokens("a"), Attributes: map[string]interface{}{"price": 5}})
+	engine.IndexDocument(4, search.DocumentIndexData{Tokens: testTokens("a")})
+	engine.FlushIndex()
+
+	// 缺少字段的文档的位置与排序方向无关，它们之间按DocId从大到小
+	for _, c := range []struct {
+		field    search.SortField
+		expected string
+	}{
+		{search.SortField{Field: "price"}, "[3 1 4 2]"},
+		{search.SortField{Field: "price", Missing: search.MissingFirst}, "[4 2 3 1]"},
+		{search.SortField{Field: "price", Descending: true}, "[1 3 4 2]"},
+		{search.SortField{Field: "price", Descending: true, Missing: search.MissingFirst}, "[4 2 1 3]"},
+	} {
+		docIds := searchDocIds(t, engine, search.SearchRequest{
+			Tokens: []string{"a"}, RankOptions: &search.RankOptions{Sort: []search.SortField{c.field}}})
+		if fmt.Sprint(docIds) != c.expected {
+			t.Errorf("按%+v排序为%v，应为%s", c.field, docIds, c.expected)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package search
+
+//拼写纠错：搜索没有结果时，用索引中存在的搜索键拼出相近的查询
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// 最多返回的纠错查询数
+	numQuerySuggestions = 3
+
+	// 每个关键词最多保留的候选搜索键数
+	numCorrectionCandidates = 5
+
+	// 纠错时的最大编辑距离，同样不超过关键词字符数的一半，见fuzzyDistance
+	maxCorrectionDistance = 2
+
+	// 混淆字之间替换的代价，其他字符之间替换、插入和删除的代价为1
+	confusionCost = 0.5
+
+	// 编辑距离每增加1，候选搜索键的得分减少的量，与log2(文档数)相比较
+	correctionDistancePenalty = 4
+)
+
+// 一个纠错后的查询，见SearchResponse.Suggestions
+type QuerySuggestion struct {
+	// 纠错后的查询文本，可以直接作为SearchRequest.Text
+	Text string
+
+	// 纠错后的关键词，可以直接作为SearchRequest.Tokens
+	Tokens []string
+
+	// 查询的得分，越大越可能是用户想要的查询
+	Score float64
+}
+
+// 常见的同音、近音和形近字，同一组中的字容易互相写错
+var confusionSets = []string{
+	"的地得", "在再", "做作坐座", "已以", "象像相向", "那哪", "他她它", "即既及级",
+	"帐账", "形型行", "付副", "须需", "题提", "历厉利", "竟竞境", "到道",
+	"是事试市式", "汇会绘", "记纪计", "经径", "议意义", "部步布", "买卖", "机几基",
+	"进近", "华化话", "为位未", "元园原圆员", "暴爆", "辨辩", "采彩", "查察",
+	"常长尝", "城成诚程", "场厂", "度渡", "反返", "份分", "候后", "练炼",
+	"密秘", "幕墓慕暮", "启起", "气汽器", "权全", "收受", "书输", "务物误",
+	"消销", "装状", "备被", "报抱", "品聘", "版板",
+}
+
+// 每个字和它的混淆字
+var confusions = buildConfusions(confusionSets)
+
+func buildConfusions(sets []string) map[rune][]rune {
+	confusions := make(map[rune][]rune)
+	for _, set := range sets {
+		runes := []rune(set)
+		for _, a := range runes {
+			for _, b := range runes {
+				if a != b && !containsRune(confusions[a], b) {
+					confusions[a] = append(confusions[a], b)
+				}
+			}
+		}
+	}
+	return confusions
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, x := range runes {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
+
+// 加权的编辑距离，混淆字之间替换的代价为confusionCost
+func correctionDistance(a, b string) float64 {
+	source, target := []rune(a), []rune(b)
+	previous := make([]float64, len(target)+1)
+	row := make([]float64, len(target)+1)
+	for j := range previous {
+		previous[j] = float64(j)
+	}
+	for i := 1; i <= len(source); i++ {
+		row[0] = float64(i)
+		for j := 1; j <= len(target); j++ {
+			cost := 1.0
+			if source[i-1] == target[j-1] {
+				cost = 0
+			} else if containsRune(confusions[source[i-1]], target[j-1]) {
+				cost = confusionCost
+			}
+			row[j] = math.Min(math.Min(row[j-1]+1, previous[j]+1), previous[j-1]+cost)
+		}
+		previous, row = row, previous
+	}
+	return previous[len(target)]
+}
+
+// 把token中的一个字替换为混淆字得到的全部字符串
+func confusionVariants(token string) (variants []string) {
+	runes := []rune(token)
+	for i, r := range runes {
+		for _, confusion := range confusions[r] {
+			runes[i] = confusion
+			variants = append(variants, string(runes))
+		}
+		runes[i] = r
+	}
+	return
+}
+
+// 一个关键词的候选搜索键
+type correctionCandidate struct {
+	term  string
+	score float64
+}
+
+// 为没有结果的搜索生成纠错查询
+//
+// 对每个关键词，从索引中找出编辑距离不超过maxCorrectionDistance的搜索键，以及把其中
+// 的字替换为混淆字后得到的搜索键，按文档数和加权编辑距离打分。再组合各关键词的候选，
+// 返回与原查询不同、得分最高的几个查询。索引中找不到任何候选的关键词被去掉。
+func (engine *Engine) suggestQueries(tokens []string) []QuerySuggestion {
+	if len(tokens) == 0 {
+		return nil
+	}
+	tokens = engine.mergeSingleRunes(tokens)
+
+	// 收集全部候选搜索键，一次查出它们的文档数
+	candidates := make([][]string, len(tokens))
+	var terms []string
+	for i, token := range tokens {
+		seen := map[string]bool{token: true}
+		candidates[i] = []string{token}
+		add := func(term string) {
+			if !seen[term] {
+				seen[term] = true
+				candidates[i] = append(candidates[i], term)
+			}
+		}
+		if distance := fuzzyDistance(token, maxCorrectionDistance); distance > 0 {
+			for _, indexer := range engine.indexers {
+				for _, term := range indexer.FuzzyTerms(token, distance) {
+					add(term.Term)
+				}
+			}
+		}
+		for _, term := range confusionVariants(token) {
+			add(term)
+		}
+		terms = append(terms, candidates[i]...)
+	}
+	stats := engine.CorpusStats(terms)
+
+	// 给每个关键词的候选打分，只保留索引中存在的搜索键
+	scored := make([][]correctionCandidate, 0, len(tokens))
+	for i, token := range tokens {
+		var list []correctionCandidate
+		for _, term := range candidates[i] {
+			frequency := stats.DocumentFrequencies[term]
+			if frequency == 0 {
+				continue
+			}
+			score := math.Log2(float64(frequency)+1) -
+				correctionDistancePenalty*correctionDistance(token, term)
+			list = append(list, correctionCandidate{term, score})
+		}
+		if len(list) == 0 {
+			continue
+		}
+		sort.Slice(list, func(a, b int) bool {
+			if list[a].score != list[b].score {
+				return list[a].score > list[b].score
+			}
+			return list[a].term < list[b].term
+		})
+		if len(list) > numCorrectionCandidates {
+			list = list[:numCorrectionCandidates]
+		}
+		scored = append(scored, list)
+	}
+	if len(scored) == 0 {
+		return nil
+	}
+
+	// 逐个关键词扩展，保留得分最高的若干组合
+	beamWidth := numQuerySuggestions + 1
+	beam := []QuerySuggestion{{}}
+	for _, list := range scored {
+		next := make([]QuerySuggestion, 0, len(beam)*len(list))
+		for _, partial := range beam {
+			for _, candidate := range list {
+				next = append(next, QuerySuggestion{
+					Tokens: append(append([]string{}, partial.Tokens...), candidate.term),
+					Score:  partial.Score + candidate.score,
+				})
+			}
+		}
+		sort.SliceStable(next, func(a, b int) bool { return next[a].Score > next[b].Score })
+		if len(next) > beamWidth {
+			next = next[:beamWidth]
+		}
+		beam = next
+	}
+
+	original := strings.Join(tokens, "\x00")
+	var suggestions []QuerySuggestion
+	for _, suggestion := range beam {
+		if strings.Join(suggestion.Tokens, "\x00") == original {
+			continue
+		}
+		suggestion.Text = joinTokens(suggestion.Tokens)
+		suggestions = append(suggestions, suggestion)
+		if len(suggestions) == numQuerySuggestions {
+			break
+		}
+	}
+	return suggestions
+}
+
+// 词典中没有的错别字词通常被分词器切成单字，比如"化为"被切成"化"和"为"。
+// 相邻的两个单字合并后（或者替换一个混淆字后）是索引中的搜索键时，把它们合并为一个关键词
+func (engine *Engine) mergeSingleRunes(tokens []string) []string {
+	merged := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		if i+1 < len(tokens) && utf8.RuneCountInString(tokens[i]) == 1 &&
+			utf8.RuneCountInString(tokens[i+1]) == 1 {
+			token := tokens[i] + tokens[i+1]
+			if engine.existsAny(append(confusionVariants(token), token)) {
+				merged = append(merged, token)
+				i++
+				continue
+			}
+		}
+		merged = append(merged, tokens[i])
+	}
+	return merged
+}
+
+// terms中是否有索引中存在的搜索键
+func (engine *Engine) existsAny(terms []string) bool {
+	stats := engine.CorpusStats(terms)
+	for _, term := range terms {
+		if stats.DocumentFrequencies[term] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// 收集用于纠错的关键词：分词后不在NOT之下的搜索键，不做同义词展开
+func (engine *Engine) correctionTokens(request *SearchRequest) []string {
+	var query *Query
+	if request.Query != nil {
+		query = request.Query
+	} else if request.Text != "" {
+		query = textQuery(request.Text, request.Phrase, request.Slop)
+	} else {
+		return normalizeTokens(request.Tokens)
+	}
+	var tokens []string
+	for _, term := range query.Terms() {
+		tokens = append(tokens, engine.tokenizePinyin(term)...)
+	}
+	return tokens
+}
+
+// 把关键词拼成查询文本，只在两个字母或数字之间加空格，中文关键词直接相连
+func joinTokens(tokens []string) string {
+	var builder strings.Builder
+	for i, token := range tokens {
+		if i > 0 {
+			last, _ := utf8.DecodeLastRuneInString(tokens[i-1])
+			first, _ := utf8.DecodeRuneInString(token)
+			if isASCIIWord(last) && isASCIIWord(first) {
+				builder.WriteByte(' ')
+			}
+		}
+		builder.WriteString(token)
+	}
+	return builder.String()
+}
+
+func isASCIIWord(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
@@ -0,0 +1,62 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+// 返回搜索的纠错查询文本
+func suggestionTexts(t *testing.T, engine *search.Engine, request search.SearchRequest) []string {
+	response, err := engine.Search(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Suggestions) > 0 && response.TotalHits != 0 {
+		t.Errorf("命中%d个文档时返回了纠错查询", response.TotalHits)
+	}
+	var texts []string
+	for _, suggestion := range response.Suggestions {
+		if suggestion.Text == "" || len(suggestion.Tokens) == 0 {
+			t.Errorf("纠错查询为%+v", suggestion)
+		}
+		texts = append(texts, suggestion.Text)
+	}
+	return texts
+}
+
+func TestSpellSuggestions(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{Segmenter: newTestSegmenter(t), NumShards: 2})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "华为手机价格"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "华为手机发布"})
+	engine.IndexDocument(3, search.DocumentIndexData{Content: "苹果电脑价格"})
+	engine.IndexDocument(4, search.DocumentIndexData{Content: "iphone price"})
+	engine.FlushIndex()
+
+	// 混淆字：错字"化"使"华为"被切成单字，合并后替换为混淆字得到索引中的搜索键
+	texts := suggestionTexts(t, engine, search.SearchRequest{Text: "化为手机"})
+	if len(texts) == 0 || texts[0] != "华为手机" {
+		t.Fatalf("化为手机的纠错查询为%v，应以华为手机开头", texts)
+	}
+	// 纠错后的查询能搜到文档
+	if docIds := searchDocIds(t, engine, search.SearchRequest{Text: texts[0]}); len(docIds) != 2 {
+		t.Errorf("纠错查询%s命中%v", texts[0], docIds)
+	}
+
+	// 编辑距离
+	if texts := suggestionTexts(t, engine, search.SearchRequest{Text: "iphome price"}); len(texts) == 0 || texts[0] != "iphone price" {
+		t.Errorf("iphome price的纠错查询为%v，应以iphone price开头", texts)
+	}
+
+	// 有结果时不纠错
+	for _, text := range []string{"华为手机", "电脑价格", "iphone"} {
+		if texts := suggestionTexts(t, engine, search.SearchRequest{Text: text}); len(texts) != 0 {
+			t.Errorf("%s有结果时返回了纠错查询%v", text, texts)
+		}
+	}
+
+	// 索引中找不到任何相近搜索键时没有纠错查询
+	if texts := suggestionTexts(t, engine, search.SearchRequest{Text: "zzzzzz"}); len(texts) != 0 {
+		t.Errorf("zzzzzz的纠错查询为%v", texts)
+	}
+}
@@ -0,0 +1,254 @@
+package search
+
+//输入提示：按前缀补全已索引的关键词
+
+import (
+	"container/heap"
+	"strings"
+	"sync"
+)
+
+// 一个补全结果
+type Suggestion struct {
+	Text string
+
+	// 包含该关键词的文档数，加上SetSuggestionWeight设置的权重
+	Weight float64
+}
+
+// 按字符（rune）组织的前缀树
+// 每个节点记录子树中的最大权重，补全时优先展开权重最大的分支，
+// 不必遍历前缀下的全部关键词
+type suggestTrie struct {
+	lock sync.Mutex
+	root *suggestNode
+
+	// 每个文档贡献的关键词，用于替换和删除文档时减去旧的计数
+	docTerms map[uint64][]string
+}
+
+type suggestNode struct {
+	children map[rune]*suggestNode
+
+	// 以本节点结尾的关键词，不是关键词时为空
+	term string
+
+	// 包含该关键词的文档数和额外的权重
+	count int
+	extra float64
+
+	// 子树（包括本节点）中关键词的最大权重
+	max float64
+}
+
+func (node *suggestNode) weight() float64 {
+	if node.term == "" {
+		return 0
+	}
+	return float64(node.count) + node.extra
+}
+
+func newSuggestTrie() *suggestTrie {
+	return &suggestTrie{root: &suggestNode{}, docTerms: make(map[uint64][]string)}
+}
+
+// 记录文档的关键词，替换该文档之前的关键词
+func (trie *suggestTrie) setDocument(docId uint64, terms []string) {
+	trie.lock.Lock()
+	defer trie.lock.Unlock()
+	for _, term := range trie.docTerms[docId] {
+		trie.update(term, func(node *suggestNode) { node.count-- })
+	}
+	for _, term := range terms {
+		trie.update(term, func(node *suggestNode) { node.count++ })
+	}
+	if len(terms) > 0 {
+		trie.docTerms[docId] = terms
+	} else {
+		delete(trie.docTerms, docId)
+	}
+}
+
+// 删除文档的关键词
+func (trie *suggestTrie) removeDocument(docId uint64) {
+	trie.setDocument(docId, nil)
+}
+
+// 设置关键词的额外权重
+func (trie *suggestTrie) setWeight(text string, weight float64) {
+	trie.lock.Lock()
+	defer trie.lock.Unlock()
+	trie.update(text, func(node *suggestNode) { node.extra = weight })
+}
+
+// 修改关键词所在的节点，然后自下而上更新路径上的最大权重，
+// 删除不再包含任何关键词的节点。调用者必须持有锁
+func (trie *suggestTrie) update(text string, modify func(node *suggestNode)) {
+	if text == "" {
+		return
+	}
+	runes := []rune(text)
+	path := make([]*suggestNode, 0, len(runes)+1)
+	node := trie.root
+	path = append(path, node)
+	for _, r := range runes {
+		child, found := node.children[r]
+		if !found {
+			if node.children == nil {
+				node.children = make(map[rune]*suggestNode)
+			}
+			child = &suggestNode{}
+			node.children[r] = child
+		}
+		node = child
+		path = append(path, node)
+	}
+
+	node.term = text
+	modify(node)
+	if node.count <= 0 && node.extra == 0 {
+		node.count = 0
+		node.term = ""
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		node := path[i]
+		node.max = node.weight()
+		for _, child := range node.children {
+			if child.max > node.max {
+				node.max = child.max
+			}
+		}
+		if i > 0 && node.term == "" && len(node.children) == 0 {
+			delete(path[i-1].children, runes[i-1])
+		}
+	}
+}
+
+// 返回以prefix开头、权重最大的n个关键词，按权重从大到小排列
+func (trie *suggestTrie) complete(prefix string, n int) []Suggestion {
+	trie.lock.Lock()
+	defer trie.lock.Unlock()
+
+	node := trie.root
+	for _, r := range prefix {
+		node = node.children[r]
+		if node == nil {
+			return nil
+		}
+	}
+
+	// 按权重展开节点，弹出的关键词总是剩余关键词中权重最大的一个
+	var suggestions []Suggestion
+	queue := &suggestQueue{{node: node, weight: node.max}}
+	for queue.Len() > 0 && len(suggestions) < n {
+		item := heap.Pop(queue).(suggestItem)
+		if item.node == nil {
+			suggestions = append(suggestions, Suggestion{Text: item.term, Weight: item.weight})
+			continue
+		}
+		if item.node.term != "" {
+			heap.Push(queue, suggestItem{term: item.node.term, weight: item.node.weight()})
+		}
+		for _, child := range item.node.children {
+			heap.Push(queue, suggestItem{node: child, weight: child.max})
+		}
+	}
+	return suggestions
+}
+
+// 补全时待展开的节点，或者node为nil时为一个关键词
+type suggestItem struct {
+	node   *suggestNode
+	term   string
+	weight float64
+}
+
+// 权重最大的排在堆顶。权重相同时先展开节点，使同样权重的关键词都进入堆中，
+// 再按字典序弹出
+type suggestQueue []suggestItem
+
+func (queue suggestQueue) Len() int {
+	return len(queue)
+}
+func (queue suggestQueue) Swap(i, j int) {
+	queue[i], queue[j] = queue[j], queue[i]
+}
+func (queue suggestQueue) Less(i, j int) bool {
+	if queue[i].weight != queue[j].weight {
+		return queue[i].weight > queue[j].weight
+	}
+	if (queue[i].node == nil) != (queue[j].node == nil) {
+		return queue[i].node != nil
+	}
+	return queue[i].term < queue[j].term
+}
+func (queue *suggestQueue) Push(x interface{}) {
+	*queue = append(*queue, x.(suggestItem))
+}
+func (queue *suggestQueue) Pop() interface{} {
+	old := *queue
+	last := old[len(old)-1]
+	*queue = old[:len(old)-1]
+	return last
+}
+
+// 文档中参与补全的关键词，不包括标签和拼音
+func suggestTerms(document *DocumentIndex) []string {
+	excluded := make(map[string]bool, len(document.Labels)+len(document.Pinyin))
+	for _, label := range document.Labels {
+		excluded[label] = true
+	}
+	for _, pinyin := range document.Pinyin {
+		excluded[pinyin] = true
+	}
+	terms := make([]string, 0, len(document.Keywords))
+	for _, keyword := range document.Keywords {
+		if !excluded[keyword.Text] {
+			terms = append(terms, keyword.Text)
+		}
+	}
+	return terms
+}
+
+// 返回以prefix开头的关键词，最多n个，按权重从大到小排列
+//
+// 关键词来自已索引文档的分词结果（不包括标签和拼音），权重为包含该关键词的文档数加上
+// SetSuggestionWeight设置的权重。prefix按字符匹配，支持中文前缀，与关键词一样先经过
+// 归一化并转为小写，繁体、全角和大写的前缀也能补全。
+// 需要设置EngineInitOptions.EnableSuggestions，否则返回ErrSuggestionsDisabled。
+func (engine *Engine) Suggest(prefix string, n int) ([]Suggestion, error) {
+	if !engine.initialized {
+		return nil, ErrNotInitialized
+	}
+	if err := engine.enter(); err != nil {
+		return nil, err
+	}
+	defer engine.requests.Done()
+
+	if engine.suggester == nil {
+		return nil, ErrSuggestionsDisabled
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+	return engine.suggester.complete(strings.ToLower(NormalizeText(prefix)), n), nil
+}
+
+// 设置补全时text的额外权重，比如按搜索次数统计的热度，weight为0时取消
+// text不必出现在已索引的文档中，可以用来加入热门的搜索短语，与Suggest的前缀一样做归一化
+func (engine *Engine) SetSuggestionWeight(text string, weight float64) error {
+	if !engine.initialized {
+		return ErrNotInitialized
+	}
+	if err := engine.enter(); err != nil {
+		return err
+	}
+	defer engine.requests.Done()
+
+	if engine.suggester == nil {
+		return ErrSuggestionsDisabled
+	}
+	engine.suggester.setWeight(strings.ToLower(NormalizeText(text)), weight)
+	return nil
+}
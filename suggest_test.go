@@ -0,0 +1,38 @@
+package search_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+func TestSuggestNormalizesPrefix(t *testing.T) {
+	engine := newTestEngine(t, search.EngineInitOptions{
+		Segmenter:         newTestSegmenter(t),
+		EnableSuggestions: true,
+	})
+	engine.IndexDocument(1, search.DocumentIndexData{Content: "華為手機價格"})
+	engine.IndexDocument(2, search.DocumentIndexData{Content: "iPhone价格"})
+	engine.FlushIndex()
+	if err := engine.SetSuggestionWeight("華為Mate", 0.5); err != nil {
+		t.Fatal(err)
+	}
+
+	for prefix, want := range map[string]string{
+		"华":   "[{华为 1} {华为mate 0.5}]",
+		"華":   "[{华为 1} {华为mate 0.5}]",
+		"華為M": "[{华为mate 0.5}]",
+		"IPH": "[{iphone 1}]",
+		"ｉｐ":  "[{iphone 1}]",
+		"價":   "[{价格 2}]",
+	} {
+		suggestions, err := engine.Suggest(prefix, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(suggestions) != want {
+			t.Errorf("%s: 补全为%v，应为%s", prefix, suggestions, want)
+		}
+	}
+}
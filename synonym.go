@@ -0,0 +1,322 @@
+package search
+
+//同义词管理
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// 同义词表
+//
+// 文件中每行一条规则，空行和#开头的注释被忽略：
+//
+//	电脑, 计算机, PC		等价规则，搜索其中任意一个词时也匹配其他词
+//	iphone => 苹果手机, 苹果		单向规则，搜索iphone时也匹配苹果手机和苹果，反之不成立
+//
+// 单向规则左边也可以有多个逗号分隔的词。同一个词出现在多条规则中时，展开的结果合并。
+// 词与关键词一样做归一化并转为小写。
+type Synonyms struct {
+	// 搜索某个词时需要同时匹配的词，不包括该词本身
+	expansions map[string][]string
+}
+
+// 从synonymFile中读入同义词规则，格式见Synonyms的注释
+// 文件无法读取或者规则格式错误时返回包装了ErrLoadSynonyms的错误
+func (synonyms *Synonyms) Init(synonymFile string) error {
+	synonyms.expansions = make(map[string][]string)
+	if synonymFile == "" {
+		return nil
+	}
+
+	file, err := os.Open(synonymFile)
+	if err != nil {
+		return fmt.Errorf("%w \"%s\": %v", ErrLoadSynonyms, synonymFile, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		if err := synonyms.AddRule(scanner.Text()); err != nil {
+			return fmt.Errorf("%w \"%s\" 第%d行: %v", ErrLoadSynonyms, synonymFile, line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w \"%s\": %v", ErrLoadSynonyms, synonymFile, err)
+	}
+	return nil
+}
+
+// 加入一条规则，格式与同义词文件中的一行相同，空行和注释被忽略
+func (synonyms *Synonyms) AddRule(rule string) error {
+	if index := strings.IndexByte(rule, '#'); index >= 0 {
+		rule = rule[:index]
+	}
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return nil
+	}
+	if synonyms.expansions == nil {
+		synonyms.expansions = make(map[string][]string)
+	}
+
+	// 单向规则
+	if index := strings.Index(rule, "=>"); index >= 0 {
+		from := splitSynonyms(rule[:index])
+		to := splitSynonyms(rule[index+len("=>"):])
+		if len(from) == 0 || len(to) == 0 {
+			return fmt.Errorf("单向规则 \"%s\" 的两边都不能为空", rule)
+		}
+		for _, a := range from {
+			for _, b := range to {
+				synonyms.add(a, b)
+			}
+		}
+		return nil
+	}
+
+	// 等价规则
+	words := splitSynonyms(rule)
+	if len(words) < 2 {
+		return fmt.Errorf("等价规则 \"%s\" 至少需要两个词", rule)
+	}
+	for _, a := range words {
+		for _, b := range words {
+			synonyms.add(a, b)
+		}
+	}
+	return nil
+}
+
+// 记录搜索a时也匹配b
+func (synonyms *Synonyms) add(a, b string) {
+	if a == b {
+		return
+	}
+	synonyms.expansions[a] = appendSynonym(synonyms.expansions[a], b)
+}
+
+// 返回搜索token时需要同时匹配的词，按字典序排列
+func (synonyms *Synonyms) Expand(token string) []string {
+	return synonyms.expansions[token]
+}
+
+// 是否没有任何规则
+func (synonyms *Synonyms) IsEmpty() bool {
+	return len(synonyms.expansions) == 0
+}
+
+// 按逗号切分规则的一边，去掉空白和空项，词与关键词一样做归一化并转为小写
+func splitSynonyms(text string) (words []string) {
+	for _, word := range strings.Split(strings.ToLower(NormalizeText(text)), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+	return
+}
+
+// 在有序列表中插入word，已经存在时不重复插入
+func appendSynonym(words []string, word string) []string {
+	index := sort.SearchStrings(words, word)
+	if index < len(words) && words[index] == word {
+		return words
+	}
+	words = append(words, "")
+	copy(words[index+1:], words[index:])
+	words[index] = word
+	return words
+}
+
+// 按分词结果整理的同义词规则，由引擎初始化时根据Synonyms生成
+//
+// 规则中的词可能被分成多个关键词，比如"苹果手机"分成"苹果"和"手机"。搜索时匹配
+// 搜索键分词后连续的关键词，建立索引时匹配文档中紧邻出现的关键词，两种方式的结果相同。
+type synonymRules struct {
+	// 从搜索词的第一个关键词到以它开头的规则，关键词多的规则排在前面
+	rules map[string][]*synonymRule
+
+	// 从同义词的第一个关键词到以它开头的同义词，用于建立索引时展开
+	targets map[string][]*synonymTarget
+}
+
+// 搜索tokens时需要同时匹配expansions中的每个同义词
+type synonymRule struct {
+	tokens     []string
+	expansions [][]string
+}
+
+// 文档中紧邻出现tokens时，搜索sources中的每个词都应当匹配该文档
+type synonymTarget struct {
+	tokens  []string
+	sources [][]string
+}
+
+// 用引擎的分词器整理同义词规则，分词后为空的词被忽略
+func (engine *Engine) compileSynonyms() *synonymRules {
+	compiled := &synonymRules{
+		rules:   make(map[string][]*synonymRule),
+		targets: make(map[string][]*synonymTarget),
+	}
+	words := make([]string, 0, len(engine.synonyms.expansions))
+	for word := range engine.synonyms.expansions {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	targets := make(map[string]*synonymTarget)
+	for _, word := range words {
+		rule := &synonymRule{tokens: engine.tokenize(word)}
+		if len(rule.tokens) == 0 {
+			continue
+		}
+		for _, synonym := range engine.synonyms.Expand(word) {
+			tokens := engine.tokenize(synonym)
+			key := strings.Join(tokens, "\x00")
+			if len(tokens) == 0 || key == strings.Join(rule.tokens, "\x00") {
+				continue
+			}
+			rule.expansions = append(rule.expansions, tokens)
+
+			target, found := targets[key]
+			if !found {
+				target = &synonymTarget{tokens: tokens}
+				targets[key] = target
+				compiled.targets[tokens[0]] = append(compiled.targets[tokens[0]], target)
+			}
+			target.sources = append(target.sources, rule.tokens)
+		}
+		if len(rule.expansions) > 0 {
+			compiled.rules[rule.tokens[0]] = append(compiled.rules[rule.tokens[0]], rule)
+		}
+	}
+	for _, rules := range compiled.rules {
+		sort.SliceStable(rules, func(i, j int) bool { return len(rules[i].tokens) > len(rules[j].tokens) })
+	}
+	return compiled
+}
+
+// 找出从tokens开头匹配的最长规则，没有时返回nil
+func (compiled *synonymRules) match(tokens []string) *synonymRule {
+	for _, rule := range compiled.rules[tokens[0]] {
+		if hasTokenPrefix(tokens, rule.tokens) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func hasTokenPrefix(tokens, prefix []string) bool {
+	if len(tokens) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if tokens[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// 将分词后的关键词展开为同义词组，返回与关键词顺序对应的子查询
+//
+// 连续的关键词匹配某条规则时，这些关键词（多个时为AND查询）和规则中的同义词组成
+// 一个同义词组，同义词分出多个关键词时作为短语匹配。没有规则的关键词保持为搜索键。
+// 在建立索引时展开同义词的情况下不做展开。
+func (engine *Engine) expandSynonyms(tokens []string) []*Query {
+	children := make([]*Query, 0, len(tokens))
+	for i := 0; i < len(tokens); {
+		var rule *synonymRule
+		if !engine.initOptions.IndexSynonyms {
+			rule = engine.synonymRules.match(tokens[i:])
+		}
+		if rule == nil {
+			children = append(children, NewTermQuery(tokens[i]))
+			i++
+			continue
+		}
+
+		group := make([]*Query, 0, len(rule.expansions)+1)
+		if len(rule.tokens) == 1 {
+			group = append(group, NewTermQuery(tokens[i]))
+		} else {
+			terms := make([]*Query, len(rule.tokens))
+			for j, token := range rule.tokens {
+				terms[j] = NewTermQuery(token)
+			}
+			group = append(group, NewAndQuery(terms...))
+		}
+		for _, expansion := range rule.expansions {
+			if len(expansion) == 1 {
+				group = append(group, NewTermQuery(expansion[0]))
+			} else {
+				group = append(group, NewPhraseQuery(0, expansion...))
+			}
+		}
+		children = append(children, NewSynonymQuery(group...))
+		i += len(rule.tokens)
+	}
+	return children
+}
+
+// 在建立索引时为文档加入同义词：文档中紧邻出现某个同义词的全部关键词时，
+// 在第一个关键词的位置加入所有应当匹配它的搜索词，搜索词分出多个关键词时都加在这个位置
+func (engine *Engine) indexSynonyms(tokensMap map[string][]int) {
+	if !engine.initOptions.IndexSynonyms || len(engine.synonymRules.targets) == 0 {
+		return
+	}
+	added := make(map[string][]int)
+	for token, locations := range tokensMap {
+		for _, target := range engine.synonymRules.targets[token] {
+			for _, location := range locations {
+				if !followedBy(tokensMap, target.tokens, location) {
+					continue
+				}
+				for _, source := range target.sources {
+					for _, t := range source {
+						added[t] = append(added[t], location)
+					}
+				}
+			}
+		}
+	}
+	mergeLocations(tokensMap, added)
+}
+
+// tokens[0]出现在location时，tokens中其余的关键词是否依次紧接着出现
+func followedBy(tokensMap map[string][]int, tokens []string, location int) bool {
+	for i := 1; i < len(tokens); i++ {
+		location += len(tokens[i-1])
+		found := false
+		for _, l := range tokensMap[tokens[i]] {
+			if l == location {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// 把added中的关键词位置并入tokensMap，合并后的位置按升序排列并去重
+func mergeLocations(tokensMap map[string][]int, added map[string][]int) {
+	for token, locations := range added {
+		// 不能在原来的切片上追加，它可能来自用户提交的DocumentIndexData.Tokens
+		locations = append(append([]int{}, tokensMap[token]...), locations...)
+		sort.Ints(locations)
+		n := 0
+		for i, location := range locations {
+			if i == 0 || location != locations[n-1] {
+				locations[n] = location
+				n++
+			}
+		}
+		tokensMap[token] = locations[:n]
+	}
+}
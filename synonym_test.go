@@ -0,0 +1,94 @@
+package search_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/aosen/search"
+)
+
+func TestSynonymsWithSegmenter(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "synonyms.txt")
+	if err := os.WriteFile(file, []byte("电脑, 计算机\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, indexSynonyms := range []bool{false, true} {
+		engine := newTestEngine(t, search.EngineInitOptions{
+			Segmenter:     newTestSegmenter(t),
+			SynonymFile:   file,
+			IndexSynonyms: indexSynonyms,
+		})
+		engine.IndexDocument(1, search.DocumentIndexData{Content: "电脑价格"})
+		engine.IndexDocument(2, search.DocumentIndexData{Content: "计算机发布"})
+		engine.IndexDocument(3, search.DocumentIndexData{Content: "手机价格"})
+		engine.FlushIndex()
+
+		for _, text := range []string{"电脑", "计算机", "电脑价格"} {
+			docIds := searchDocIds(t, engine, search.SearchRequest{Text: text})
+			sort.Slice(docIds, func(i, j int) bool { return docIds[i] < docIds[j] })
+			want := "[1 2]"
+			if text == "电脑价格" {
+				want = "[1]"
+			}
+			if fmt.Sprint(docIds) != want {
+				t.Errorf("IndexSynonyms=%v %s: 命中%v，应为%s", indexSynonyms, text, docIds, want)
+			}
+		}
+	}
+}
+
+// 在两种展开方式下检查搜索结果
+func checkSynonymSearches(t *testing.T, rules string, contents []string, searches map[string]string) {
+	file := filepath.Join(t.TempDir(), "synonyms.txt")
+	if err := os.WriteFile(file, []byte(rules), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, indexSynonyms := range []bool{false, true} {
+		engine := newTestEngine(t, search.EngineInitOptions{
+			Segmenter:     newTestSegmenter(t),
+			SynonymFile:   file,
+			IndexSynonyms: indexSynonyms,
+			NumShards:     2,
+		})
+		for i, content := range contents {
+			engine.IndexDocument(uint64(i+1), search.DocumentIndexData{Content: content})
+		}
+		engine.FlushIndex()
+
+		for text, want := range searches {
+			docIds := searchDocIds(t, engine, search.SearchRequest{Text: text})
+			sort.Slice(docIds, func(i, j int) bool { return docIds[i] < docIds[j] })
+			if fmt.Sprint(docIds) != want {
+				t.Errorf("IndexSynonyms=%v %s: 命中%v，应为%s", indexSynonyms, text, docIds, want)
+			}
+		}
+	}
+}
+
+func TestSynonymsMixedCase(t *testing.T) {
+	checkSynonymSearches(t, "电脑, 计算机, PC\n",
+		[]string{"电脑价格", "PC价格", "计算机发布", "手机价格"},
+		map[string]string{
+			"PC":   "[1 2 3]",
+			"pc":   "[1 2 3]",
+			"电脑":   "[1 2 3]",
+			"PC价格": "[1 2]",
+		})
+}
+
+func TestSynonymsWithMultipleTokens(t *testing.T) {
+	checkSynonymSearches(t, "iphone => 苹果手机\n华为手机 => mate\n",
+		[]string{"苹果手机价格", "iphone价格", "苹果电脑价格", "手机价格苹果", "mate价格", "华为手机发布"},
+		map[string]string{
+			// 同义词分出多个关键词时要求它们紧邻出现
+			"iphone": "[1 2]",
+			"苹果手机":   "[1 4]",
+			// 搜索词分出多个关键词时匹配连续的关键词
+			"华为手机":   "[5 6]",
+			"华为手机价格": "[5]",
+			"mate":   "[5]",
+		})
+}